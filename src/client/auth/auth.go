@@ -13,6 +13,12 @@ const (
 	// authenticated context
 	ContextTokenKey = "authn-token"
 
+	// ContextTenantKey is the key of the auth tenant name in a request
+	// context. On clusters that serve multiple auth tenants, it selects the
+	// logical auth domain (admins, ACLs, config) that handles the request;
+	// requests without it are handled by the cluster's default domain
+	ContextTenantKey = "auth-tenant"
+
 	// The following constants are Subject prefixes. These are prepended to
 	// Subjects in the 'tokens' collection, and Principals in 'admins' and on ACLs
 	// to indicate what type of Subject or Principal they are (every Pachyderm
@@ -32,6 +38,11 @@ const (
 	// (with this prefix) is a logical PPS pipeline (even though the pipeline may
 	// not exist).
 	PipelinePrefix = "pipeline:"
+
+	// GroupPrefix indicates that this Subject is a group of users. Groups can
+	// appear anywhere a Subject can (e.g. on ACLs, in the admins collection, or
+	// as members of other groups--group membership is resolved transitively).
+	GroupPrefix = "group:"
 )
 
 // ParseScope parses the string 's' to a scope (for example, parsing a command-
@@ -71,6 +82,17 @@ var (
 	// ErrBadToken is returned by the Auth API if the caller's token is corrupted
 	// or has expired.
 	ErrBadToken = status.Error(codes.Unauthenticated, "provided auth token is corrupted or has expired (try logging in again)")
+
+	// ErrEnterpriseRequired is returned by auth.Activate if the cluster has no
+	// active Pachyderm Enterprise token (the auth API is an Enterprise-level
+	// feature, so auth cannot be activated)
+	ErrEnterpriseRequired = status.Error(codes.FailedPrecondition, "Pachyderm Enterprise is not active in this cluster, and the Pachyderm auth API is an Enterprise-level feature")
+
+	// ErrExpiredEnterprise is returned by the Auth API if the cluster's
+	// Pachyderm Enterprise token expired while auth was active (in this state,
+	// only cluster admins can perform any operations, until Enterprise is
+	// re-activated or auth is deactivated)
+	ErrExpiredEnterprise = status.Error(codes.FailedPrecondition, "Pachyderm Enterprise is not active in this cluster (until Pachyderm Enterprise is re-activated or Pachyderm auth is deactivated, only cluster admins can perform any operations)")
 )
 
 // IsErrNotActivated checks if an error is a ErrNotActivated
@@ -120,6 +142,22 @@ func IsErrBadToken(err error) bool {
 	return strings.Contains(err.Error(), status.Convert(ErrBadToken).Message())
 }
 
+// IsErrEnterpriseRequired returns true if 'err' is an ErrEnterpriseRequired
+func IsErrEnterpriseRequired(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), status.Convert(ErrEnterpriseRequired).Message())
+}
+
+// IsErrExpiredEnterprise returns true if 'err' is an ErrExpiredEnterprise
+func IsErrExpiredEnterprise(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), status.Convert(ErrExpiredEnterprise).Message())
+}
+
 // ErrNotAuthorized is returned if the user is not authorized to perform
 // a certain operation. Either
 // 1) the operation is a user operation, in which case 'Repo' and/or 'Required'
@@ -161,6 +199,13 @@ func (e *ErrNotAuthorized) Error() string {
 	return msg
 }
 
+// GRPCStatus returns e as a gRPC status, so that when e crosses a gRPC
+// boundary the client sees codes.PermissionDenied instead of codes.Unknown
+// (the grpc library checks for this method when converting errors)
+func (e *ErrNotAuthorized) GRPCStatus() *status.Status {
+	return status.New(codes.PermissionDenied, e.Error())
+}
+
 // IsErrNotAuthorized checks if an error is a ErrNotAuthorized
 func IsErrNotAuthorized(err error) bool {
 	if err == nil {
@@ -171,6 +216,41 @@ func IsErrNotAuthorized(err error) bool {
 	return strings.Contains(err.Error(), errNotAuthorizedMsg)
 }
 
+// ErrInsufficientScope is a more specific form of ErrNotAuthorized, returned
+// where the auth API knows both the scope an operation requires and the scope
+// the caller actually has, so that clients (e.g. the dash) can tell users
+// exactly what access they're missing
+type ErrInsufficientScope struct {
+	Subject string // subject trying to perform the blocked operation
+	Repo    string // Repo that the subject is attempting to access
+
+	Required Scope // the level of access the operation requires
+	Actual   Scope // the level of access the subject actually has
+}
+
+func (e *ErrInsufficientScope) Error() string {
+	// this message deliberately contains errNotAuthorizedMsg, so that
+	// IsErrNotAuthorized also matches ErrInsufficientScope errors
+	return fmt.Sprintf("%s is %s on the repo %s; must have at least %s access but only has %s access",
+		e.Subject, errNotAuthorizedMsg, e.Repo, e.Required, e.Actual)
+}
+
+// GRPCStatus returns e as a gRPC status (see ErrNotAuthorized.GRPCStatus)
+func (e *ErrInsufficientScope) GRPCStatus() *status.Status {
+	return status.New(codes.PermissionDenied, e.Error())
+}
+
+// IsErrInsufficientScope returns true if 'err' is an ErrInsufficientScope
+func IsErrInsufficientScope(err error) bool {
+	if err == nil {
+		return false
+	}
+	errMsg := err.Error()
+	return strings.Contains(errMsg, errNotAuthorizedMsg) &&
+		strings.Contains(errMsg, "; must have at least ") &&
+		strings.Contains(errMsg, " access but only has ")
+}
+
 // ErrInvalidPrincipal indicates that a an argument to e.g. GetScope,
 // SetScope, or SetACL is invalid
 type ErrInvalidPrincipal struct {
@@ -181,6 +261,11 @@ func (e *ErrInvalidPrincipal) Error() string {
 	return fmt.Sprintf("invalid principal \"%s\"; must start with one of \"pipeline:\", \"github:\", or \"robot:\", or have no \":\"", e.Principal)
 }
 
+// GRPCStatus returns e as a gRPC status (see ErrNotAuthorized.GRPCStatus)
+func (e *ErrInvalidPrincipal) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
 // IsErrInvalidPrincipal returns true if 'err' is an ErrInvalidPrincipal
 func IsErrInvalidPrincipal(err error) bool {
 	if err == nil {
@@ -202,6 +287,11 @@ func (e ErrTooShortTTL) Error() string {
 	return fmt.Sprintf(errTooShortTTLMsg, e.RequestTTL, e.ExistingTTL)
 }
 
+// GRPCStatus returns e as a gRPC status (see ErrNotAuthorized.GRPCStatus)
+func (e ErrTooShortTTL) GRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
 // IsErrTooShortTTL returns true if 'err' is a ErrTooShortTTL
 func IsErrTooShortTTL(err error) bool {
 	if err == nil {
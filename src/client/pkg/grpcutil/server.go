@@ -1,6 +1,7 @@
 package grpcutil
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -46,6 +47,12 @@ type ServerOptions struct {
 	Cancel       chan struct{}
 	RegisterFunc func(*grpc.Server) error
 
+	// If set, UnaryInterceptor and StreamInterceptor run after the tracing
+	// interceptors on every call to this server (the grpc library only accepts
+	// one interceptor of each kind, so they're chained here)
+	UnaryInterceptor  grpc.UnaryServerInterceptor
+	StreamInterceptor grpc.StreamServerInterceptor
+
 	// If set, grpcutil may enable TLS.  This should be set for public ports that
 	// serve GRPC services to 3rd party clients.
 	//
@@ -59,6 +66,43 @@ type ServerOptions struct {
 	PublicPortTLSAllowed bool
 }
 
+// ChainUnaryServerInterceptors combines 'first' and 'rest' into one
+// interceptor that runs them in order (any of 'rest' may be nil, as the grpc
+// library in use only accepts a single interceptor per server)
+func ChainUnaryServerInterceptors(first grpc.UnaryServerInterceptor, rest ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	result := first
+	for _, second := range rest {
+		if second == nil {
+			continue
+		}
+		first, second := result, second
+		result = func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			return first(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return second(ctx, req, info, handler)
+			})
+		}
+	}
+	return result
+}
+
+// ChainStreamServerInterceptors is the streaming analogue of
+// ChainUnaryServerInterceptors
+func ChainStreamServerInterceptors(first grpc.StreamServerInterceptor, rest ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	result := first
+	for _, second := range rest {
+		if second == nil {
+			continue
+		}
+		first, second := result, second
+		result = func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return first(srv, ss, info, func(srv interface{}, ss grpc.ServerStream) error {
+				return second(srv, ss, info, handler)
+			})
+		}
+	}
+	return result
+}
+
 // Serve serves stuff.
 func Serve(
 	servers ...ServerOptions,
@@ -78,8 +122,8 @@ func Serve(
 				MinTime:             5 * time.Second,
 				PermitWithoutStream: true,
 			}),
-			grpc.UnaryInterceptor(tracing.UnaryServerInterceptor()),
-			grpc.StreamInterceptor(tracing.StreamServerInterceptor()),
+			grpc.UnaryInterceptor(ChainUnaryServerInterceptors(tracing.UnaryServerInterceptor(), server.UnaryInterceptor)),
+			grpc.StreamInterceptor(ChainStreamServerInterceptors(tracing.StreamServerInterceptor(), server.StreamInterceptor)),
 		}
 		if server.PublicPortTLSAllowed {
 			// Validate environment
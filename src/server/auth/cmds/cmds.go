@@ -3,8 +3,16 @@ package cmds
 import (
 	"bufio"
 	"fmt"
+	"net"
+	"net/http"
+	"net/url"
 	"os"
+	"os/exec"
+	"path"
+	"runtime"
+	"sort"
 	"strings"
+	"text/tabwriter"
 	"text/template"
 	"time"
 
@@ -135,17 +143,134 @@ func DeactivateCmd() *cobra.Command {
 	return cmdutil.CreateAlias(deactivate, "auth deactivate")
 }
 
+// browserLoginTimeout is how long 'pachctl auth login --idp' waits for the
+// user to finish authenticating with the ID provider in their browser (it
+// matches how long pachd remembers the SP-initiated login request)
+const browserLoginTimeout = 5 * time.Minute
+
+// samlLoginURL computes the URL of pachd's SP-initiated SAML login handler
+// (/saml/login). The ACS URL in the cluster's auth config is authoritative
+// (it reflects any proxy in front of pachd's SAML service), but reading the
+// config requires a logged-in user, so if 'c' isn't (or is no longer) logged
+// in this falls back to pachd's address and the default SAML port
+func samlLoginURL(c *client.APIClient, idp string) (*url.URL, error) {
+	cfgResp, err := c.GetConfiguration(c.Ctx(), &auth.GetConfigurationRequest{})
+	if err != nil {
+		host := c.GetAddress()
+		if colon := strings.LastIndex(host, ":"); colon >= 0 {
+			host = host[:colon]
+		}
+		return &url.URL{
+			Scheme: "http",
+			Host:   fmt.Sprintf("%s:654", host), // pachd's default SAML port
+			Path:   "/saml/login",
+		}, nil
+	}
+	cfg := cfgResp.Configuration
+	var idpCfg *auth.IDProvider
+	for _, i := range cfg.IDProviders {
+		if i.Name == idp {
+			idpCfg = i
+			break
+		}
+	}
+	if idpCfg == nil {
+		return nil, fmt.Errorf("no ID provider named %q in this cluster's auth config", idp)
+	}
+	if idpCfg.SAML == nil {
+		return nil, fmt.Errorf("ID provider %q doesn't support browser-based login", idp)
+	}
+	if cfg.SAMLServiceOptions == nil || cfg.SAMLServiceOptions.ACSURL == "" {
+		return nil, fmt.Errorf("this cluster's auth config has no ACS URL (set saml_svc_options)")
+	}
+	u, err := url.Parse(cfg.SAMLServiceOptions.ACSURL)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse this cluster's ACS URL: %v", err)
+	}
+	// the login handler is served next to the ACS (".../saml/acs" ->
+	// ".../saml/login"), preserving any path prefix added by a proxy
+	u.Path = path.Join(path.Dir(u.Path), "login")
+	return u, nil
+}
+
+// openInBrowser opens 'url' in the user's browser, or returns an error if no
+// browser could be started (the caller prints the URL as a fallback)
+func openInBrowser(url string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", url).Start()
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", url).Start()
+	default:
+		return exec.Command("xdg-open", url).Start()
+	}
+}
+
+// browserLogin drives the SP-initiated login flow for 'pachctl auth login
+// --idp': it opens the ID provider's login page in the user's browser and
+// receives the resulting One-Time Password on a localhost callback, which the
+// caller exchanges for a Pachyderm token via Authenticate
+func browserLogin(c *client.APIClient, idp string) (string, error) {
+	loginURL, err := samlLoginURL(c, idp)
+	if err != nil {
+		return "", err
+	}
+
+	// Listen for the callback before opening the browser, so the auth code
+	// can't arrive before pachctl is ready to receive it
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", fmt.Errorf("could not listen for login callback: %v", err)
+	}
+	defer listener.Close()
+	codeCh := make(chan string, 1)
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		code := req.URL.Query().Get("auth_code")
+		if code == "" {
+			http.Error(w, "no auth code in login callback", http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintln(w, "You are now logged in to Pachyderm. You can close "+
+			"this window and return to your terminal.")
+		select {
+		case codeCh <- code:
+		default: // a code has already been received--ignore duplicates
+		}
+	})}
+	go srv.Serve(listener)
+	defer srv.Close()
+
+	loginURL.RawQuery = url.Values{
+		"redirect": []string{fmt.Sprintf("http://%s/callback", listener.Addr().String())},
+	}.Encode()
+	fmt.Printf("Opening your browser to log in to Pachyderm. If it doesn't "+
+		"open, please visit:\n\n  %s\n\n", loginURL.String())
+	if err := openInBrowser(loginURL.String()); err != nil {
+		fmt.Printf("(could not open browser: %v)\n", err)
+	}
+	select {
+	case code := <-codeCh:
+		return code, nil
+	case <-time.After(browserLoginTimeout):
+		return "", fmt.Errorf("timed out waiting to be redirected back from the ID provider")
+	}
+}
+
 // LoginCmd returns a cobra.Command to login to a Pachyderm cluster with your
 // GitHub account. Any resources that have been restricted to the email address
 // registered with your GitHub account will subsequently be accessible.
 func LoginCmd() *cobra.Command {
 	var useOTP bool
+	var idp string
 	login := &cobra.Command{
 		Short: "Log in to Pachyderm",
 		Long: "Login to Pachyderm. Any resources that have been restricted to " +
 			"the account you have with your ID provider (e.g. GitHub, Okta) " +
 			"account will subsequently be accessible.",
 		Run: cmdutil.Run(func([]string) error {
+			if useOTP && idp != "" {
+				return fmt.Errorf("cannot pass both --one-time-password and --idp")
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return fmt.Errorf("could not connect: %v", err)
@@ -155,7 +280,18 @@ func LoginCmd() *cobra.Command {
 			// Issue authentication request to Pachyderm and get response
 			var resp *auth.AuthenticateResponse
 			var authErr error
-			if useOTP {
+			if idp != "" {
+				// Log in via the ID provider's login page, and exchange the
+				// resulting short-lived auth code for a Pachyderm token
+				code, err := browserLogin(c, idp)
+				if err != nil {
+					return err
+				}
+				fmt.Println("Retrieving Pachyderm token...")
+				resp, authErr = c.Authenticate(
+					c.Ctx(),
+					&auth.AuthenticateRequest{OneTimePassword: code})
+			} else if useOTP {
 				// Exhange short-lived Pachyderm auth code for long-lived Pachyderm token
 				fmt.Println("Please enter your Pachyderm One-Time Password:")
 				code, err := bufio.NewReader(os.Stdin).ReadString('\n')
@@ -194,6 +330,10 @@ func LoginCmd() *cobra.Command {
 	login.PersistentFlags().BoolVarP(&useOTP, "one-time-password", "o", false,
 		"If set, authenticate with a Dash-provided One-Time Password, rather than "+
 			"via GitHub")
+	login.PersistentFlags().StringVar(&idp, "idp", "",
+		"If set, log in through the named SAML ID provider from the cluster's "+
+			"auth config: pachctl opens the provider's login page in your browser "+
+			"and receives your credential when you finish authenticating there")
 	return cmdutil.CreateAlias(login, "auth login")
 }
 
@@ -254,41 +394,129 @@ func WhoamiCmd() *cobra.Command {
 	return cmdutil.CreateAlias(whoami, "auth whoami")
 }
 
-// CheckCmd returns a cobra command that sends an "Authorize" RPC to Pachd, to
-// determine whether the specified user has access to the specified repo.
+// printAccessExplanation renders one principal's effective access to a repo
+// (as returned by ExplainScope or WhoCanAccess) for display
+func printAccessExplanation(explanation *auth.AccessExplanation) {
+	fmt.Printf("%s: %s\n", explanation.Subject, explanation.Scope)
+	if explanation.DirectScope != auth.Scope_NONE {
+		fmt.Printf("  %s via the repo's ACL\n", explanation.DirectScope)
+	}
+	groups := make([]string, 0, len(explanation.GroupScopes))
+	for group := range explanation.GroupScopes {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	for _, group := range groups {
+		fmt.Printf("  %s via membership in %s\n", explanation.GroupScopes[group], group)
+	}
+	if explanation.IsAdmin {
+		fmt.Printf("  %s as a cluster admin\n", auth.Scope_OWNER)
+	}
+}
+
+// CheckCmd returns a cobra command that determines your (or, with --user,
+// another user's) level of access to a repo
 func CheckCmd() *cobra.Command {
+	var user string
 	check := &cobra.Command{
-		Use:   "{{alias}} (none|reader|writer|owner) <repo>",
-		Short: "Check whether you have reader/writer/etc-level access to 'repo'",
-		Long: "Check whether you have reader/writer/etc-level access to 'repo'. " +
-			"For example, 'pachctl auth check reader private-data' prints \"true\" " +
-			"if the you have at least \"reader\" access to the repo " +
-			"\"private-data\" (you could be a reader, writer, or owner). Unlike " +
-			"`pachctl auth get`, you do not need to have access to 'repo' to " +
-			"discover your own access level.",
-		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
-			scope, err := auth.ParseScope(args[0])
+		Use:   "{{alias}} [(none|reader|writer|owner)] <repo>",
+		Short: "Check the level of access you (or another user) have to 'repo'",
+		Long: "Check the level of access you (or another user, with --user) " +
+			"have to 'repo'. Called with just a repo, this prints your effective " +
+			"access level and how it's derived (directly from the repo's ACL, " +
+			"inherited from a group, or implied by cluster admin status); only " +
+			"cluster admins can check another user's access. Called with a scope " +
+			"and a repo, e.g. 'pachctl auth check reader private-data', it prints " +
+			"\"true\" if you have at least \"reader\" access to \"private-data\" " +
+			"(you could be a reader, writer, or owner). Unlike `pachctl auth " +
+			"get`, you do not need to have access to 'repo' to discover your own " +
+			"access level.",
+		Run: cmdutil.RunBoundedArgs(1, 2, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
 			if err != nil {
-				return err
+				return fmt.Errorf("could not connect: %v", err)
+			}
+			defer c.Close()
+			if len(args) == 2 {
+				// Check whether the caller has 'scope'-level access to 'repo'
+				if user != "" {
+					return fmt.Errorf("--user can only be set when checking a repo " +
+						"without a scope")
+				}
+				scope, err := auth.ParseScope(args[0])
+				if err != nil {
+					return err
+				}
+				repo := args[1]
+				resp, err := c.Authorize(c.Ctx(), &auth.AuthorizeRequest{
+					Repo:  repo,
+					Scope: scope,
+				})
+				if err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+				fmt.Printf("%t\n", resp.Authorized)
+				return nil
+			}
+			// Explain the caller's (or --user's) effective access to 'repo'
+			resp, err := c.ExplainScope(c.Ctx(), &auth.ExplainScopeRequest{
+				Repo:     args[0],
+				Username: user,
+			})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printAccessExplanation(resp.Explanation)
+			return nil
+		}),
+	}
+	check.PersistentFlags().StringVar(&user, "user", "", "explain this user's "+
+		"access to the repo, rather than your own (cluster admins only)")
+	return cmdutil.CreateAlias(check, "auth check")
+}
+
+// WhoCanCmd returns a cobra command that lists every principal with access to
+// a repo
+func WhoCanCmd() *cobra.Command {
+	var scopeStr string
+	whoCan := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "List who can access 'repo', and how their access is derived",
+		Long: "List every principal with access to 'repo' (users with direct " +
+			"ACL entries, members of groups with ACL entries, and cluster admins) " +
+			"and how each principal's access is derived. The caller must own " +
+			"'repo' or be a cluster admin.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			var scope auth.Scope
+			if scopeStr != "" {
+				var err error
+				if scope, err = auth.ParseScope(scopeStr); err != nil {
+					return err
+				}
 			}
-			repo := args[1]
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return fmt.Errorf("could not connect: %v", err)
 			}
 			defer c.Close()
-			resp, err := c.Authorize(c.Ctx(), &auth.AuthorizeRequest{
-				Repo:  repo,
+			resp, err := c.WhoCanAccess(c.Ctx(), &auth.WhoCanAccessRequest{
+				Repo:  args[0],
 				Scope: scope,
 			})
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
-			fmt.Printf("%t\n", resp.Authorized)
+			for _, explanation := range resp.Explanations {
+				printAccessExplanation(explanation)
+			}
 			return nil
 		}),
 	}
-	return cmdutil.CreateAlias(check, "auth check")
+	whoCan.PersistentFlags().StringVar(&scopeStr, "scope", "", "only list "+
+		"principals with at least this level of access "+
+		"(none|reader|writer|owner); the default lists everyone with at least "+
+		"reader access")
+	return cmdutil.CreateAlias(whoCan, "auth who-can")
 }
 
 // GetCmd returns a cobra command that gets either the ACL for a Pachyderm
@@ -490,6 +718,128 @@ func UseAuthTokenCmd() *cobra.Command {
 	return cmdutil.CreateAlias(useAuthToken, "auth use-auth-token")
 }
 
+// tokenExpiration renders the remaining TTL of an auth token (in seconds, or
+// -1 if the token never expires) for display
+func tokenExpiration(ttl int64) string {
+	if ttl < 0 {
+		return "never"
+	}
+	return time.Now().Add(time.Duration(ttl) * time.Second).Format(time.RFC822)
+}
+
+// ListTokensCmd returns a cobra command that lists the cluster's auth tokens
+func ListTokensCmd() *cobra.Command {
+	var user string
+	listTokens := &cobra.Command{
+		Short: "List the cluster's auth tokens (cluster admins only)",
+		Long: "List the cluster's auth tokens (cluster admins only). Tokens are " +
+			"stored hashed, so the first column is the hash under which each token " +
+			"is stored rather than the token itself; the hash can't be used to " +
+			"authenticate, but it identifies tokens across list-tokens and " +
+			"describe-token output.",
+		Run: cmdutil.Run(func([]string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return fmt.Errorf("could not connect: %v", err)
+			}
+			defer c.Close()
+			resp, err := c.ListTokens(c.Ctx(), &auth.ListTokensRequest{
+				Subject: user,
+			})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 2, 0, 3, ' ', 0)
+			fmt.Fprintln(writer, "HASHED TOKEN\tSUBJECT\tSOURCE\tEXPIRES")
+			for _, record := range resp.Records {
+				fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", record.HashedToken,
+					record.Subject, record.Source, tokenExpiration(record.TTL))
+			}
+			return writer.Flush()
+		}),
+	}
+	listTokens.PersistentFlags().StringVar(&user, "user", "", "if set, only "+
+		"list tokens belonging to this user")
+	return cmdutil.CreateAlias(listTokens, "auth list-tokens")
+}
+
+// DescribeTokenCmd returns a cobra command that prints the subject, source,
+// and expiration of an auth token
+func DescribeTokenCmd() *cobra.Command {
+	describeToken := &cobra.Command{
+		Short: "Describe an auth token read from stdin",
+		Long: "Read a Pachyderm auth token from stdin, and print the subject it " +
+			"authenticates, how it was issued, and when it expires. Users can " +
+			"describe their own tokens, and cluster admins can describe any token.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			fmt.Println("Please paste the Pachyderm auth token to describe:")
+			token, err := bufio.NewReader(os.Stdin).ReadString('\n')
+			if err != nil {
+				return fmt.Errorf("error reading token: %v", err)
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return fmt.Errorf("could not connect: %v", err)
+			}
+			defer c.Close()
+			resp, err := c.DescribeToken(c.Ctx(), &auth.DescribeTokenRequest{
+				Token: strings.TrimSpace(token), // drop trailing newline
+			})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			record := resp.Record
+			fmt.Printf("Subject: %s\nSource: %s\nExpires: %s\nHashed token: %s\n",
+				record.Subject, record.Source, tokenExpiration(record.TTL),
+				record.HashedToken)
+			return nil
+		}),
+	}
+	return cmdutil.CreateAlias(describeToken, "auth describe-token")
+}
+
+// RevokeTokenCmd returns a cobra command that revokes a single auth token, or
+// (with --user) all of a user's auth tokens
+func RevokeTokenCmd() *cobra.Command {
+	var user string
+	revokeToken := &cobra.Command{
+		Use:   "{{alias}} [<token>]",
+		Short: "Revoke an auth token, or all of a user's auth tokens",
+		Long: "Revoke an auth token, so that it can no longer be used to access " +
+			"this cluster. If called with a token, that token is revoked (users " +
+			"can revoke their own tokens, and cluster admins can revoke any " +
+			"user's token). If called with --user, all of that user's tokens are " +
+			"revoked (cluster admins only).",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			if (len(args) == 0) == (user == "") {
+				return fmt.Errorf("must pass either a token or --user (but not both)")
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return fmt.Errorf("could not connect: %v", err)
+			}
+			defer c.Close()
+			if user != "" {
+				resp, err := c.RevokeTokensForUser(c.Ctx(), &auth.RevokeTokensForUserRequest{
+					Username: user,
+				})
+				if err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+				fmt.Printf("%d token(s) revoked\n", resp.Number)
+				return nil
+			}
+			_, err = c.RevokeAuthToken(c.Ctx(), &auth.RevokeAuthTokenRequest{
+				Token: args[0],
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	revokeToken.PersistentFlags().StringVar(&user, "user", "", "if set, revoke "+
+		"all auth tokens belonging to this user (cluster admins only)")
+	return cmdutil.CreateAlias(revokeToken, "auth revoke-token")
+}
+
 // GetOneTimePasswordCmd returns a cobra command that lets a user get an OTP.
 func GetOneTimePasswordCmd() *cobra.Command {
 	getOneTimePassword := &cobra.Command{
@@ -533,12 +883,16 @@ func Cmds() []*cobra.Command {
 	commands = append(commands, LogoutCmd())
 	commands = append(commands, WhoamiCmd())
 	commands = append(commands, CheckCmd())
+	commands = append(commands, WhoCanCmd())
 	commands = append(commands, SetScopeCmd())
 	commands = append(commands, GetCmd())
 	commands = append(commands, ListAdminsCmd())
 	commands = append(commands, ModifyAdminsCmd())
 	commands = append(commands, GetAuthTokenCmd())
 	commands = append(commands, UseAuthTokenCmd())
+	commands = append(commands, ListTokensCmd())
+	commands = append(commands, DescribeTokenCmd())
+	commands = append(commands, RevokeTokenCmd())
 	commands = append(commands, GetConfigCmd())
 	commands = append(commands, SetConfigCmd())
 	commands = append(commands, GetOneTimePasswordCmd())
@@ -2,12 +2,15 @@ package server
 
 import (
 	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"path"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -16,6 +19,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/google/go-github/github"
+	lru "github.com/hashicorp/golang-lru"
 	logrus "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"golang.org/x/oauth2"
@@ -25,11 +29,12 @@ import (
 	authclient "github.com/pachyderm/pachyderm/src/client/auth"
 	enterpriseclient "github.com/pachyderm/pachyderm/src/client/enterprise"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
-	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
 	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
 	txnenv "github.com/pachyderm/pachyderm/src/server/pkg/transactionenv"
@@ -46,10 +51,14 @@ const (
 	tokensPrefix              = "/tokens"
 	authenticationCodesPrefix = "/auth-codes"
 	aclsPrefix                = "/acls"
+	aclHistoryPrefix          = "/acl-history"
 	adminsPrefix              = "/admins"
 	membersPrefix             = "/members"
 	groupsPrefix              = "/groups"
+	groupInfoPrefix           = "/group-info"
 	configPrefix              = "/config"
+	activationPrefix          = "/activation"
+	adminRevisionsPrefix      = "/admin-revisions"
 
 	defaultTokenTTLSecs = 30 * 24 * 60 * 60 // 30 days
 	defaultSAMLTTLSecs  = 24 * 60 * 60      // 24 hours
@@ -69,6 +78,35 @@ const (
 	// implemenation details of our config library, we can't use an empty key)
 	configKey = "config"
 
+	// activationStatusKey is the only key in the activationStatus collection
+	// (see configKey); it maps to the persisted progress of the most recent
+	// Activate call
+	activationStatusKey = "status"
+
+	// adminRevisionTTLSecs is how long a pachd node's acknowledgement of the
+	// admin-cache revision it has applied (see publishAdminRevision) stays in
+	// etcd without being refreshed. Entries for dead nodes expire, so they
+	// can't block Activate/Deactivate convergence forever
+	adminRevisionTTLSecs = 60
+
+	// adminRevisionHeartbeat is how often each pachd node refreshes its
+	// acknowledged admin-cache revision (must be well under
+	// adminRevisionTTLSecs)
+	adminRevisionHeartbeat = 15 * time.Second
+
+	// Names under which the auth server's background goroutines are tracked in
+	// 'watcherStates' and reported by AuthHealth
+	adminWatcher           = "admins"
+	configWatcher          = "config"
+	samlService            = "saml"
+	ppsTokenFetcher        = "pps-token"
+	enterpriseStateFetcher = "enterprise-state"
+
+	// clusterResourcePrefix is a reserved prefix under which ACLs for cluster
+	// resources (see auth.ClusterResource) are stored in the acls collection.
+	// Repo names can't contain ':', so these keys can't collide with repo ACLs
+	clusterResourcePrefix = "cluster:"
+
 	// SamlPort is the port where SAML ID Providers can send auth assertions
 	SamlPort = 654
 )
@@ -94,6 +132,10 @@ var epsilon = &types.BoolValue{Value: true}
 type APIServer interface {
 	authclient.APIServer
 	txnenv.AuthTransactionServer
+	// Close terminates the server's background goroutines (watches, the SAML
+	// service, and the admin-revision heartbeat), so that pachd shutdown and
+	// tests can tear the server down deterministically.
+	Close() error
 }
 
 // apiServer implements the public interface of the Pachyderm auth system,
@@ -103,8 +145,19 @@ type apiServer struct {
 	txnEnv     *txnenv.TransactionEnv
 	pachLogger log.Logger
 
+	// shutdownCtx is cancelled by Close; the server's background retry loops
+	// (watchAdmins, watchConfig, publishAdminRevisions) run under it so they
+	// stop cleanly instead of retrying forever after shutdown
+	shutdownCtx    context.Context
+	shutdownCancel context.CancelFunc
+
 	adminCache map[string]struct{} // cache of current cluster admins
-	adminMu    sync.Mutex          // guard 'adminCache'
+	adminRev   int64               // etcd revision of the last admin event applied to 'adminCache'
+	adminMu    sync.Mutex          // guard 'adminCache' and 'adminRev'
+
+	// instanceID distinguishes this auth server's entry in the
+	// adminRevisions collection from its peers'
+	instanceID string
 
 	// configCache should not be read/written directly--use setCacheConfig and
 	// getCacheConfig
@@ -116,6 +169,20 @@ type apiServer struct {
 	samlSP   *saml.ServiceProvider // object for parsing saml responses
 	samlSPMu sync.Mutex            // guard 'samlSP'. Always lock after 'configMu' (if using both)
 
+	// samlServer is the HTTP server currently serving the SAML services (see
+	// serveSAML); samlServerTLS records whether it's serving TLS, so that
+	// setCacheConfig can restart it when TLS is enabled or disabled
+	samlServer    *http.Server
+	samlServerTLS bool
+	samlServerMu  sync.Mutex // guard 'samlServer' and 'samlServerTLS'
+
+	// samlRequests tracks outstanding SP-initiated AuthnRequests (issued by
+	// the /saml/login handler and keyed by RelayState): which request ID the
+	// ACS should accept, and where to send the user's auth code once the IdP
+	// posts its assertion (see handleSPInitiatedLogin)
+	samlRequests   map[string]*samlLoginState
+	samlRequestsMu sync.Mutex // guard 'samlRequests'
+
 	// tokens is a collection of hashedToken -> TokenInfo mappings. These tokens are
 	// returned to users by Authenticate()
 	tokens col.Collection
@@ -125,6 +192,10 @@ type apiServer struct {
 	authenticationCodes col.Collection
 	// acls is a collection of repoName -> ACL mappings.
 	acls col.Collection
+	// aclHistory is a collection of repoName -> ACLHistory mappings (an
+	// append-only record of every SetACL/SetScope mutation, so that accidental
+	// permission changes can be reverted via RestoreACL)
+	aclHistory col.Collection
 	// admins is a collection of username -> Empty mappings (keys indicate which
 	// github users are cluster admins)
 	admins col.Collection
@@ -132,12 +203,57 @@ type apiServer struct {
 	members col.Collection
 	// groups is a collection of group -> usernames mappings.
 	groups col.Collection
+	// groupInfo is a collection of group -> GroupInfo mappings (metadata for
+	// groups created explicitly via CreateGroup)
+	groupInfo col.Collection
 	// collection containing the auth config (under the key configKey)
 	authConfig col.Collection
-
-	// This is a cache of the PPS master token. It's set once on startup and then
-	// never updated
-	ppsToken string
+	// collection containing the progress of the most recent Activate call
+	// (under the key activationStatusKey)
+	activationStatus col.Collection
+	// adminRevisions is a collection of pachd instanceID -> etcd revision
+	// mappings: each auth server publishes the revision of the last admin
+	// event it has applied, so Activate/Deactivate can wait for the whole
+	// cluster to converge (see waitForAdminConvergence)
+	adminRevisions col.Collection
+
+	// This is a cache of the PPS master token. It's set by
+	// retrieveOrGeneratePPSToken (which retries in the background until etcd
+	// is reachable) and then never updated; until it's set, operations that
+	// need the token are deferred with an error instead of crashing pachd
+	ppsToken   string
+	ppsTokenMu sync.Mutex // guard 'ppsToken'
+
+	// auditor streams audit records of mutating auth RPCs to the sinks in the
+	// cluster's auth config (see audit.go)
+	auditor *auditor
+
+	// changeNotifier POSTs change notifications to the config webhooks in the
+	// cluster's auth config whenever SetConfiguration or ModifyAdmins
+	// succeeds (see change_notify.go)
+	changeNotifier *changeNotifier
+
+	// watcherStates tracks the liveness of the auth server's long-running
+	// background goroutines (the admin/config watchers and the SAML service),
+	// which otherwise fail silently and retry forever. Reported by AuthHealth
+	watcherStates map[string]*watcherState
+	watcherMu     sync.Mutex // guard 'watcherStates'
+
+	// enterpriseState caches the cluster's enterprise activation state so the
+	// authorization paths don't call Enterprise.GetState per request (see
+	// enterprise_state.go)
+	enterpriseState enterpriseStateCache
+
+	// githubUsers caches canonicalized GitHub usernames (including negative
+	// "no such user" results) so large ACL updates don't hit GitHub's rate
+	// limit (see github_cache.go)
+	githubUsers *lru.Cache
+
+	// authStateSubs contains a wakeup channel per open WatchAuthState stream;
+	// the admin and config watchers signal these when the cluster's activation
+	// state or config version may have changed (see notifyAuthStateChange)
+	authStateSubs map[chan struct{}]struct{}
+	authStateMu   sync.Mutex // guard 'authStateSubs'
 
 	// public addresses the fact that pachd in full mode initializes two auth
 	// servers: one that exposes a public API, possibly over TLS, and one that
@@ -149,18 +265,20 @@ type apiServer struct {
 
 // LogReq is like log.Logger.Log(), but it assumes that it's being called from
 // the top level of a GRPC method implementation, and correspondingly extracts
-// the method name from the parent stack frame
+// the method name from the parent stack frame. Fields annotated with
+// (redact) = true in auth.proto are scrubbed before logging (see redact.go)
 func (a *apiServer) LogReq(request interface{}) {
-	a.pachLogger.Log(request, nil, nil, 0)
+	a.pachLogger.Log(redactedClone(request), nil, nil, 0)
 }
 
 // LogResp is like log.Logger.Log(). However,
-// 1) It assumes that it's being called from a defer() statement in a GRPC
-//    method , and correspondingly extracts the method name from the grandparent
-//    stack frame
-// 2) It logs NotActivatedError at DebugLevel instead of ErrorLevel, as, in most
-//    cases, this error is expected, and logging it frequently may confuse users
+//  1. It assumes that it's being called from a defer() statement in a GRPC
+//     method , and correspondingly extracts the method name from the grandparent
+//     stack frame
+//  2. It logs NotActivatedError at DebugLevel instead of ErrorLevel, as, in most
+//     cases, this error is expected, and logging it frequently may confuse users
 func (a *apiServer) LogResp(request interface{}, response interface{}, err error, duration time.Duration) {
+	request, response = redactedClone(request), redactedClone(response)
 	if err == nil {
 		a.pachLogger.LogAtLevelFromDepth(request, response, err, duration, logrus.InfoLevel, 4)
 	} else if authclient.IsErrNotActivated(err) {
@@ -168,35 +286,87 @@ func (a *apiServer) LogResp(request interface{}, response interface{}, err error
 	} else {
 		a.pachLogger.LogAtLevelFromDepth(request, response, err, duration, logrus.ErrorLevel, 4)
 	}
+	// Audited (i.e. mutating) RPCs are also streamed to any configured audit
+	// sinks; this includes failed calls, as denied mutations are exactly what
+	// a SIEM system wants to see
+	if a.auditor != nil {
+		if method := auditMethodName(); auditedMethods[method] {
+			record := &auditRecord{
+				Time:    time.Now(),
+				Method:  method,
+				Request: fmt.Sprintf("%v", request),
+			}
+			if err != nil {
+				record.Error = err.Error()
+			}
+			a.auditor.emit(record)
+		}
+	}
 }
 
-// NewAuthServer returns an implementation of authclient.APIServer.
+// NewAuthServer returns an implementation of authclient.APIServer. If auth
+// tenants are configured (see AUTH_TENANTS in serviceenv), the result routes
+// each request to the isolated auth domain selected by the request's
+// auth-tenant metadata key (see tenant.go); otherwise it serves the single
+// default domain directly.
 func NewAuthServer(
 	env *serviceenv.ServiceEnv,
 	txnEnv *txnenv.TransactionEnv,
 	etcdPrefix string,
 	public bool,
 ) (APIServer, error) {
+	s, err := newAPIServer(env, txnEnv, etcdPrefix, public)
+	if err != nil {
+		return nil, err
+	}
+	tenants := configuredTenants(env)
+	if len(tenants) == 0 {
+		return s, nil
+	}
+	return newTenantRouter(env, txnEnv, etcdPrefix, s, tenants)
+}
+
+// newAPIServer constructs the apiServer for a single auth domain (on most
+// clusters, the only one) rooted at 'etcdPrefix'
+func newAPIServer(
+	env *serviceenv.ServiceEnv,
+	txnEnv *txnenv.TransactionEnv,
+	etcdPrefix string,
+	public bool,
+) (*apiServer, error) {
+	// If an at-rest encryption key is configured, the collections holding
+	// secrets (tokens, authenticationCodes, and authConfig) are envelope-
+	// encrypted before they're written to etcd (see encryption.go)
+	atRestCodec, err := newAuthAtRestCodec(env)
+	if err != nil {
+		return nil, err
+	}
+	githubUsers, err := lru.New(githubUserCacheSize)
+	if err != nil {
+		return nil, err
+	}
 	s := &apiServer{
 		env:        env,
 		txnEnv:     txnEnv,
 		pachLogger: log.NewLogger("authclient.API"),
 		adminCache: make(map[string]struct{}),
-		tokens: col.NewCollection(
+		tokens: col.NewCollectionWithCodec(
 			env.GetEtcdClient(),
 			path.Join(etcdPrefix, tokensPrefix),
 			nil,
 			&authclient.TokenInfo{},
 			nil,
 			nil,
+			atRestCodec,
 		),
-		authenticationCodes: col.NewCollection(
+		authenticationCodes: col.NewCollectionWithCodec(
 			env.GetEtcdClient(),
 			path.Join(etcdPrefix, authenticationCodesPrefix),
 			nil,
 			&authclient.OTPInfo{},
 			nil,
 			nil,
+			atRestCodec,
 		),
 		acls: col.NewCollection(
 			env.GetEtcdClient(),
@@ -206,6 +376,14 @@ func NewAuthServer(
 			nil,
 			nil,
 		),
+		aclHistory: col.NewCollection(
+			env.GetEtcdClient(),
+			path.Join(etcdPrefix, aclHistoryPrefix),
+			nil,
+			&authclient.ACLHistory{},
+			nil,
+			nil,
+		),
 		admins: col.NewCollection(
 			env.GetEtcdClient(),
 			path.Join(etcdPrefix, adminsPrefix),
@@ -230,18 +408,56 @@ func NewAuthServer(
 			nil,
 			nil,
 		),
-		authConfig: col.NewCollection(
+		groupInfo: col.NewCollection(
+			env.GetEtcdClient(),
+			path.Join(etcdPrefix, groupInfoPrefix),
+			nil,
+			&authclient.GroupInfo{},
+			nil,
+			nil,
+		),
+		authConfig: col.NewCollectionWithCodec(
 			env.GetEtcdClient(),
 			path.Join(etcdPrefix, configKey),
 			nil,
 			&authclient.AuthConfig{},
 			nil,
 			nil,
+			atRestCodec,
 		),
-		public: public,
-	}
+		activationStatus: col.NewCollection(
+			env.GetEtcdClient(),
+			path.Join(etcdPrefix, activationPrefix),
+			nil,
+			&authclient.ActivationStatus{},
+			nil,
+			nil,
+		),
+		adminRevisions: col.NewCollection(
+			env.GetEtcdClient(),
+			path.Join(etcdPrefix, adminRevisionsPrefix),
+			nil,
+			&types.Int64Value{},
+			nil,
+			nil,
+		),
+		instanceID:     uuid.NewWithoutDashes(),
+		public:         public,
+		auditor:        newAuditor(),
+		changeNotifier: newChangeNotifier(),
+		watcherStates:  make(map[string]*watcherState),
+		authStateSubs:  make(map[chan struct{}]struct{}),
+		githubUsers:    githubUsers,
+		samlRequests:   make(map[string]*samlLoginState),
+	}
+	s.shutdownCtx, s.shutdownCancel = context.WithCancel(context.Background())
 	go s.retrieveOrGeneratePPSToken()
 	go s.watchAdmins(path.Join(etcdPrefix, adminsPrefix))
+	// Periodically re-publish the admin-cache revision this node has applied,
+	// so that Activate/Deactivate on peers can wait for convergence
+	go s.publishAdminRevisions()
+	// Rewrite any group names that predate canonicalization (best-effort)
+	go s.migrateGroupNames()
 
 	if public {
 		// start SAML service (won't respond to
@@ -253,6 +469,20 @@ func NewAuthServer(
 	return s, nil
 }
 
+// Close stops the server's background goroutines (admin/config watches, the
+// SAML service, and the admin-revision heartbeat). It doesn't tear down the
+// RPC machinery; it exists so tests and graceful shutdown don't leak retry
+// loops.
+func (a *apiServer) Close() error {
+	a.shutdownCancel()
+	a.samlServerMu.Lock()
+	defer a.samlServerMu.Unlock()
+	if a.samlServer != nil {
+		return a.samlServer.Close()
+	}
+	return nil
+}
+
 type activationState int
 
 const (
@@ -286,13 +516,13 @@ func (a *apiServer) activationState() activationState {
 // an API) but we should define an internal API and use that instead.
 func (a *apiServer) retrieveOrGeneratePPSToken() {
 	var tokenProto types.StringValue // will contain PPS token
-	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
-	defer cancel()
 	b := backoff.NewExponentialBackOff()
-	b.MaxElapsedTime = 60 * time.Second
-	b.MaxInterval = 5 * time.Second
-	if err := backoff.Retry(func() error {
-		if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+	// retry indefinitely: crashing pachd doesn't make etcd come back any
+	// sooner, and AuthHealth reports the degraded state in the meantime
+	b.MaxElapsedTime = 0
+	b.MaxInterval = 30 * time.Second
+	backoff.RetryUntilCancel(a.shutdownCtx, func() error {
+		if _, err := col.NewSTM(a.shutdownCtx, a.env.GetEtcdClient(), func(stm col.STM) error {
 			superUserTokenCol := col.NewCollection(a.env.GetEtcdClient(), ppsconsts.PPSTokenKey, nil, &types.StringValue{}, nil, nil).ReadWrite(stm)
 			// TODO(msteffen): Don't use an empty key, as it will not be erased by
 			// superUserTokenCol.DeleteAll()
@@ -312,20 +542,52 @@ func (a *apiServer) retrieveOrGeneratePPSToken() {
 		}); err != nil {
 			return err
 		}
-		a.ppsToken = tokenProto.Value
+		a.setPPSToken(tokenProto.Value)
+		a.recordWatcherEvent(ppsTokenFetcher)
 		return nil
-	}, b); err != nil {
-		panic(fmt.Sprintf("couldn't create/retrieve PPS superuser token within 60s of starting up: %v", err))
-	}
+	}, b, func(err error, d time.Duration) error {
+		a.recordWatcherError(ppsTokenFetcher, err)
+		logrus.Errorf("couldn't create/retrieve PPS superuser token (will retry in %v): %v", d, err)
+		return nil
+	})
+}
+
+// setPPSToken and getPPSToken guard the ppsToken cache, which is written by
+// a background goroutine (see retrieveOrGeneratePPSToken). getPPSToken
+// returns "" until the token has been retrieved.
+func (a *apiServer) setPPSToken(token string) {
+	a.ppsTokenMu.Lock()
+	defer a.ppsTokenMu.Unlock()
+	a.ppsToken = token
+}
+
+func (a *apiServer) getPPSToken() string {
+	a.ppsTokenMu.Lock()
+	defer a.ppsTokenMu.Unlock()
+	return a.ppsToken
 }
 
 func (a *apiServer) watchAdmins(fullAdminPrefix string) {
 	b := backoff.NewExponentialBackOff()
-	backoff.RetryNotify(func() error {
+	backoff.RetryUntilCancel(a.shutdownCtx, func() error {
 		// Watch for the addition/removal of new admins. Note that this will return
 		// any existing admins, so if the auth service is already activated, it will
 		// stay activated.
-		watcher, err := a.admins.ReadOnly(context.Background()).Watch()
+		//
+		// On retries, resume from the revision of the last event we applied
+		// (tracked in a.adminRev), so a transient watch error doesn't re-sync
+		// the whole admin list. If etcd has compacted that revision away, fall
+		// back to a full watch.
+		a.adminMu.Lock()
+		resumeRev := a.adminRev
+		a.adminMu.Unlock()
+		var watcher watch.Watcher
+		var err error
+		if resumeRev > 0 {
+			watcher, err = a.admins.ReadOnly(a.shutdownCtx).WatchFrom(resumeRev + 1)
+		} else {
+			watcher, err = a.admins.ReadOnly(a.shutdownCtx).Watch()
+		}
 		if err != nil {
 			return err
 		}
@@ -338,6 +600,7 @@ func (a *apiServer) watchAdmins(fullAdminPrefix string) {
 				return errors.New("admin watch closed unexpectedly")
 			}
 			b.Reset() // event successfully received
+			a.recordWatcherEvent(adminWatcher)
 
 			if err := func() error {
 				// Lock a.adminMu in case we need to modify a.adminCache
@@ -357,43 +620,168 @@ func (a *apiServer) watchAdmins(fullAdminPrefix string) {
 				case watch.EventError:
 					return ev.Err
 				}
+				if ev.Rev > a.adminRev {
+					a.adminRev = ev.Rev
+				}
 				return nil // unlock mu
 			}(); err != nil {
 				return err
 			}
+			// Acknowledge the event to peers (in particular, an Activate or
+			// Deactivate call may be blocked in waitForAdminConvergence until
+			// every pachd node has published this event's revision)
+			a.publishAdminRevision()
+			// The activation state may have changed; wake any WatchAuthState
+			// streams
+			a.notifyAuthStateChange()
 		}
 	}, b, func(err error, d time.Duration) error {
+		a.recordWatcherError(adminWatcher, err)
 		logrus.Errorf("error watching admin collection: %v; retrying in %v", err, d)
 		return nil
 	})
 }
 
-func (a *apiServer) getEnterpriseTokenState() (enterpriseclient.State, error) {
-	pachClient := a.env.GetPachClient(context.Background())
-	resp, err := pachClient.Enterprise.GetState(context.Background(),
-		&enterpriseclient.GetStateRequest{})
-	if err != nil {
-		return 0, fmt.Errorf("could not get Enterprise status: %v", grpcutil.ScrubGRPC(err))
+// watcherState records when one of the auth server's background goroutines
+// last made progress and, if its last attempt failed, the error. See
+// AuthHealth
+type watcherState struct {
+	lastEvent time.Time
+	lastError string // "" if the last attempt succeeded
+}
+
+// recordWatcherEvent marks the background goroutine 'name' as healthy (it
+// received an event or otherwise made progress)
+func (a *apiServer) recordWatcherEvent(name string) {
+	a.watcherMu.Lock()
+	defer a.watcherMu.Unlock()
+	state, ok := a.watcherStates[name]
+	if !ok {
+		state = &watcherState{}
+		a.watcherStates[name] = state
+	}
+	state.lastEvent = time.Now()
+	state.lastError = ""
+}
+
+// recordWatcherError marks the background goroutine 'name' as unhealthy
+func (a *apiServer) recordWatcherError(name string, err error) {
+	a.watcherMu.Lock()
+	defer a.watcherMu.Unlock()
+	state, ok := a.watcherStates[name]
+	if !ok {
+		state = &watcherState{}
+		a.watcherStates[name] = state
+	}
+	state.lastError = err.Error()
+}
+
+// publishAdminRevision writes the etcd revision of the last admin event this
+// node has applied to its cache into the adminRevisions collection, where
+// waitForAdminConvergence (possibly on another pachd node) can see it. The
+// entry has a TTL so that dead nodes don't block convergence forever
+func (a *apiServer) publishAdminRevision() {
+	a.adminMu.Lock()
+	rev := a.adminRev
+	a.adminMu.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		return a.adminRevisions.ReadWrite(stm).PutTTL(a.instanceID,
+			&types.Int64Value{Value: rev}, adminRevisionTTLSecs)
+	}); err != nil {
+		logrus.Errorf("error publishing admin-cache revision: %v", err)
+	}
+}
+
+// publishAdminRevisions periodically refreshes this node's entry in the
+// adminRevisions collection (see publishAdminRevision), so that the entry
+// outlives its TTL as long as this node is up
+func (a *apiServer) publishAdminRevisions() {
+	ticker := time.NewTicker(adminRevisionHeartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			a.publishAdminRevision()
+		case <-a.shutdownCtx.Done():
+			return
+		}
+	}
+}
+
+// waitForAdminConvergence blocks until every live pachd node has applied the
+// admin write committed at etcd revision 'revision' to its admin cache, per
+// the adminRevisions collection. Activate and Deactivate call this instead of
+// sleeping, so that when they return, no pachd node is still enforcing the old
+// activation state
+func (a *apiServer) waitForAdminConvergence(ctx context.Context, revision int64) error {
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = 60 * time.Second
+	b.MaxInterval = time.Second
+	return backoff.Retry(func() error {
+		var stale int
+		var appliedRev types.Int64Value
+		if err := a.adminRevisions.ReadOnly(ctx).List(&appliedRev, col.DefaultOptions, func(string) error {
+			if appliedRev.Value < revision {
+				stale++
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if stale > 0 {
+			return fmt.Errorf("%d pachd node(s) have not caught up to revision %d", stale, revision)
+		}
+		return nil
+	}, b)
+}
+
+// recordActivationStep persists the progress of an Activate call in etcd (see
+// ActivationStatus). 'step' is the last step that completed, and 'stepErr',
+// if set, is the error that stopped activation after 'step'. Failures to
+// record progress are logged but don't fail activation (the status exists to
+// help operators debug, not to gate the flow)
+func (a *apiServer) recordActivationStep(ctx context.Context, step authclient.ActivationStatus_Step, stepErr error) {
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		var status authclient.ActivationStatus
+		return a.activationStatus.ReadWrite(stm).Upsert(activationStatusKey, &status, func() error {
+			now, err := types.TimestampProto(time.Now())
+			if err != nil {
+				return err
+			}
+			if status.Started == nil {
+				status.Started = now
+			}
+			status.Step = step
+			status.Updated = now
+			status.Error = ""
+			if stepErr != nil {
+				status.Error = stepErr.Error()
+			}
+			return nil
+		})
+	}); err != nil {
+		logrus.Errorf("could not record auth activation progress: %v", err)
 	}
-	return resp.State, nil
 }
 
 // Activate implements the protobuf auth.Activate RPC
 func (a *apiServer) Activate(ctx context.Context, req *authclient.ActivateRequest) (resp *authclient.ActivateResponse, retErr error) {
 	pachClient := a.env.GetPachClient(ctx)
 	ctx = pachClient.Ctx() // copy auth information
-	// We don't want to actually log the request/response since they contain
-	// credentials.
-	defer func(start time.Time) { a.LogResp(nil, nil, retErr, time.Since(start)) }(time.Now())
+	// The credentials in the request and response are annotated with (redact)
+	// in auth.proto, so they're scrubbed from the log (see redact.go)
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
 	// If the cluster's Pachyderm Enterprise token isn't active, the auth system
 	// cannot be activated
-	state, err := a.getEnterpriseTokenState()
+	state, err := a.getEnterpriseTokenState(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error confirming Pachyderm Enterprise token: %v", err)
 	}
 	if state != enterpriseclient.State_ACTIVE {
-		return nil, fmt.Errorf("Pachyderm Enterprise is not active in this " +
-			"cluster, and the Pachyderm auth API is an Enterprise-level feature")
+		return nil, authclient.ErrEnterpriseRequired
 	}
 
 	// Activating an already activated auth service should fail, because
@@ -438,13 +826,23 @@ func (a *apiServer) Activate(ctx context.Context, req *authclient.ActivateReques
 		return nil, fmt.Errorf("invalid subject in request (must be a GitHub user or robot): \"%s\"", req.Subject)
 	}
 
+	// Read the persisted progress of any previous, failed Activate call, so
+	// that completed steps (in particular PPS.ActivateAuth, which may take a
+	// long time on clusters with many pipelines) aren't re-run
+	var status authclient.ActivationStatus
+	if err := a.activationStatus.ReadOnly(ctx).Get(activationStatusKey, &status); err != nil && !col.IsErrNotFound(err) {
+		return nil, err
+	}
+
 	// Hack: set the cluster admins to just {magicUser}. This puts the auth system
 	// in the "partial" activation state. Users cannot authenticate, but auth
 	// checks are now enforced, which means no pipelines or repos can be created
 	// while ACLs are being added to every repo for the existing pipelines
-	if _, err = col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+	txnResp, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
 		return a.admins.ReadWrite(stm).Put(magicUser, epsilon)
-	}); err != nil {
+	})
+	if err != nil {
+		a.recordActivationStep(ctx, authclient.ActivationStatus_NOT_STARTED, err)
 		return nil, err
 	}
 	// wait until watchAdmins has updated the local cache (changing the activation
@@ -457,21 +855,41 @@ func (a *apiServer) Activate(ctx context.Context, req *authclient.ActivateReques
 		}
 		return nil
 	}, b); err != nil {
+		a.recordActivationStep(ctx, authclient.ActivationStatus_NOT_STARTED, err)
 		return nil, err
 	}
-	time.Sleep(time.Second) // give other pachd nodes time to update their cache
-
-	// Call PPS.ActivateAuth to set up all affected pipelines and repos
-	superUserClient := pachClient.WithCtx(pachClient.Ctx()) // clone pachClient
-	superUserClient.SetAuthToken(a.ppsToken)
-	if _, err := superUserClient.ActivateAuth(superUserClient.Ctx(), &pps.ActivateAuthRequest{}); err != nil {
+	// wait until every other pachd node has updated its cache too
+	if err := a.waitForAdminConvergence(ctx, txnResp.Header.Revision); err != nil {
+		a.recordActivationStep(ctx, authclient.ActivationStatus_NOT_STARTED, err)
 		return nil, err
 	}
+	if status.Step < authclient.ActivationStatus_MAGIC_USER_WRITTEN {
+		a.recordActivationStep(ctx, authclient.ActivationStatus_MAGIC_USER_WRITTEN, nil)
+	}
+
+	// Call PPS.ActivateAuth to set up all affected pipelines and repos (unless
+	// a previous Activate attempt already finished this step)
+	if status.Step < authclient.ActivationStatus_PPS_ACTIVATED {
+		ppsToken := a.getPPSToken()
+		if ppsToken == "" {
+			err := errors.New("the auth server has not yet retrieved the PPS " +
+				"superuser token (is etcd healthy?); try again in a few seconds")
+			a.recordActivationStep(ctx, authclient.ActivationStatus_MAGIC_USER_WRITTEN, err)
+			return nil, err
+		}
+		superUserClient := pachClient.WithCtx(pachClient.Ctx()) // clone pachClient
+		superUserClient.SetAuthToken(ppsToken)
+		if _, err := superUserClient.ActivateAuth(superUserClient.Ctx(), &pps.ActivateAuthRequest{}); err != nil {
+			a.recordActivationStep(ctx, authclient.ActivationStatus_MAGIC_USER_WRITTEN, err)
+			return nil, err
+		}
+		a.recordActivationStep(ctx, authclient.ActivationStatus_PPS_ACTIVATED, nil)
+	}
 
 	// Generate a new Pachyderm token (as the caller is authenticating) and
 	// initialize admins (watchAdmins() above will see the write)
 	pachToken := uuid.NewWithoutDashes()
-	if _, err = col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+	txnResp, err = col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
 		admins := a.admins.ReadWrite(stm)
 		tokens := a.tokens.ReadWrite(stm)
 		if err := admins.Delete(magicUser); err != nil {
@@ -488,7 +906,9 @@ func (a *apiServer) Activate(ctx context.Context, req *authclient.ActivateReques
 			},
 			ttlSecs,
 		)
-	}); err != nil {
+	})
+	if err != nil {
+		a.recordActivationStep(ctx, authclient.ActivationStatus_PPS_ACTIVATED, err)
 		return nil, err
 	}
 
@@ -504,12 +924,190 @@ func (a *apiServer) Activate(ctx context.Context, req *authclient.ActivateReques
 		}
 		return nil
 	}, b); err != nil {
+		a.recordActivationStep(ctx, authclient.ActivationStatus_PPS_ACTIVATED, err)
+		return nil, err
+	}
+	// wait until every other pachd node has updated its cache too
+	if err := a.waitForAdminConvergence(ctx, txnResp.Header.Revision); err != nil {
+		a.recordActivationStep(ctx, authclient.ActivationStatus_PPS_ACTIVATED, err)
 		return nil, err
 	}
-	time.Sleep(time.Second) // give other pachd nodes time to update their cache
+	a.recordActivationStep(ctx, authclient.ActivationStatus_COMPLETE, nil)
 	return &authclient.ActivateResponse{PachToken: pachToken}, nil
 }
 
+// GetActivationStatus implements the protobuf auth.GetActivationStatus RPC.
+// It's available in every activation state (its purpose is to let operators
+// see why a cluster is stuck in the "partial" state, in which nobody can
+// authenticate)
+func (a *apiServer) GetActivationStatus(ctx context.Context, req *authclient.GetActivationStatusRequest) (resp *authclient.GetActivationStatusResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+
+	response := &authclient.GetActivationStatusResponse{}
+	switch a.activationState() {
+	case none:
+		response.State = "none"
+	case partial:
+		response.State = "partial"
+	case full:
+		response.State = "full"
+		// In the "full" state the cluster is operating normally, so require
+		// that the caller at least be logged in (mirroring GetAdmins)
+		if _, err := a.getAuthenticatedUser(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var status authclient.ActivationStatus
+	if err := a.activationStatus.ReadOnly(ctx).Get(activationStatusKey, &status); err != nil {
+		if !col.IsErrNotFound(err) {
+			return nil, err
+		}
+		return response, nil
+	}
+	response.Status = &status
+	return response, nil
+}
+
+// AuthHealth implements the protobuf auth.AuthHealth RPC. Like
+// GetActivationStatus, it's available in every activation state: its purpose
+// is to let operators alert on an auth server whose background goroutines
+// have silently died or are stuck retrying
+func (a *apiServer) AuthHealth(ctx context.Context, req *authclient.AuthHealthRequest) (resp *authclient.AuthHealthResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+
+	response := &authclient.AuthHealthResponse{Healthy: true}
+	switch a.activationState() {
+	case none:
+		response.State = "none"
+	case partial:
+		response.State = "partial"
+	case full:
+		response.State = "full"
+		// In the "full" state the cluster is operating normally, so require
+		// that the caller at least be logged in (mirroring GetActivationStatus)
+		if _, err := a.getAuthenticatedUser(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if cfg := a.getCacheConfig(); cfg != nil {
+		response.ConfigVersion = cfg.Version
+	}
+	func() {
+		a.samlSPMu.Lock()
+		defer a.samlSPMu.Unlock()
+		response.SamlEnabled = a.samlSP != nil
+	}()
+
+	// Report the watchers in a deterministic order
+	a.watcherMu.Lock()
+	defer a.watcherMu.Unlock()
+	names := make([]string, 0, len(a.watcherStates))
+	for name := range a.watcherStates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		state := a.watcherStates[name]
+		status := &authclient.WatcherStatus{
+			Name:      name,
+			Healthy:   state.lastError == "",
+			LastError: state.lastError,
+		}
+		if !state.lastEvent.IsZero() {
+			lastEvent, err := types.TimestampProto(state.lastEvent)
+			if err != nil {
+				return nil, err
+			}
+			status.LastEvent = lastEvent
+		}
+		if !status.Healthy {
+			response.Healthy = false
+		}
+		response.Watchers = append(response.Watchers, status)
+	}
+	return response, nil
+}
+
+// activationStateName renders an activationState for API responses
+func activationStateName(state activationState) string {
+	switch state {
+	case partial:
+		return "partial"
+	case full:
+		return "full"
+	}
+	return "none"
+}
+
+// subscribeAuthState registers a new WatchAuthState stream and returns its
+// wakeup channel (see notifyAuthStateChange)
+func (a *apiServer) subscribeAuthState() chan struct{} {
+	sub := make(chan struct{}, 1)
+	a.authStateMu.Lock()
+	defer a.authStateMu.Unlock()
+	a.authStateSubs[sub] = struct{}{}
+	return sub
+}
+
+// unsubscribeAuthState removes a WatchAuthState stream's wakeup channel
+func (a *apiServer) unsubscribeAuthState(sub chan struct{}) {
+	a.authStateMu.Lock()
+	defer a.authStateMu.Unlock()
+	delete(a.authStateSubs, sub)
+}
+
+// notifyAuthStateChange wakes every open WatchAuthState stream. It never
+// blocks: each stream's channel holds at most one pending wakeup, and streams
+// re-read the current state when they wake, so coalescing signals is safe
+func (a *apiServer) notifyAuthStateChange() {
+	a.authStateMu.Lock()
+	defer a.authStateMu.Unlock()
+	for sub := range a.authStateSubs {
+		select {
+		case sub <- struct{}{}:
+		default: // this stream already has a wakeup pending
+		}
+	}
+}
+
+// WatchAuthState implements the protobuf auth.WatchAuthState RPC
+func (a *apiServer) WatchAuthState(req *authclient.WatchAuthStateRequest, stream authclient.API_WatchAuthStateServer) (retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, nil, retErr, time.Since(start)) }(time.Now())
+	// In the "full" state the cluster is operating normally, so require that
+	// the caller at least be logged in (mirroring AuthHealth)
+	if a.activationState() == full {
+		if _, err := a.getAuthenticatedUser(stream.Context()); err != nil {
+			return err
+		}
+	}
+	sub := a.subscribeAuthState()
+	defer a.unsubscribeAuthState(sub)
+	var last *authclient.WatchAuthStateResponse
+	for {
+		cur := &authclient.WatchAuthStateResponse{
+			State: activationStateName(a.activationState()),
+		}
+		if cfg := a.getCacheConfig(); cfg != nil {
+			cur.ConfigVersion = cfg.Version
+		}
+		if last == nil || cur.State != last.State || cur.ConfigVersion != last.ConfigVersion {
+			if err := stream.Send(cur); err != nil {
+				return err
+			}
+			last = cur
+		}
+		select {
+		case <-sub:
+		case <-stream.Context().Done():
+			return nil // the client is done watching
+		}
+	}
+}
+
 // Deactivate implements the protobuf auth.Deactivate RPC
 func (a *apiServer) Deactivate(ctx context.Context, req *authclient.DeactivateRequest) (resp *authclient.DeactivateResponse, retErr error) {
 	a.LogReq(req)
@@ -529,14 +1127,21 @@ func (a *apiServer) Deactivate(ctx context.Context, req *authclient.DeactivateRe
 		_, magicUserIsAdmin = a.adminCache[magicUser]
 	}()
 	if magicUserIsAdmin {
-		_, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		admins, err := a.admins.ReadOnly(ctx).Count()
+		if err != nil {
+			return nil, err
+		}
+		if req.DryRun {
+			return &authclient.DeactivateResponse{Admins: admins}, nil
+		}
+		if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
 			a.admins.ReadWrite(stm).DeleteAll() // watchAdmins() will see the write
+			a.activationStatus.ReadWrite(stm).DeleteAll()
 			return nil
-		})
-		if err != nil {
+		}); err != nil {
 			return nil, err
 		}
-		return &authclient.DeactivateResponse{}, nil
+		return &authclient.DeactivateResponse{Admins: admins}, nil
 	}
 
 	// Get calling user. The user must be a cluster admin to disable auth for the
@@ -555,13 +1160,46 @@ func (a *apiServer) Deactivate(ctx context.Context, req *authclient.DeactivateRe
 			AdminOp: "DeactivateAuth",
 		}
 	}
-	_, err = col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+
+	// Count the auth state that deactivation will delete, so that it can be
+	// reported (and so that dry runs have something to return)
+	response := &authclient.DeactivateResponse{}
+	for _, count := range []struct {
+		field      *int64
+		collection col.Collection
+	}{
+		{&response.Tokens, a.tokens},
+		{&response.ACLs, a.acls},
+		{&response.Admins, a.admins},
+		{&response.Groups, a.groups},
+	} {
+		var err error
+		if *count.field, err = count.collection.ReadOnly(ctx).Count(); err != nil {
+			return nil, err
+		}
+	}
+	if req.DryRun {
+		return response, nil
+	}
+
+	// Optionally archive the complete auth state to the object store before
+	// deleting it
+	if req.ArchiveURL != "" {
+		if err := a.archiveAuthState(ctx, req.ArchiveURL); err != nil {
+			return nil, fmt.Errorf("could not archive auth state (auth is still active): %v", err)
+		}
+	}
+
+	txnResp, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
 		a.acls.ReadWrite(stm).DeleteAll()
+		a.aclHistory.ReadWrite(stm).DeleteAll()
 		a.tokens.ReadWrite(stm).DeleteAll()
 		a.admins.ReadWrite(stm).DeleteAll() // watchAdmins() will see the write
 		a.members.ReadWrite(stm).DeleteAll()
 		a.groups.ReadWrite(stm).DeleteAll()
+		a.groupInfo.ReadWrite(stm).DeleteAll()
 		a.authConfig.ReadWrite(stm).DeleteAll()
+		a.activationStatus.ReadWrite(stm).DeleteAll()
 		return nil
 	})
 	if err != nil {
@@ -581,8 +1219,11 @@ func (a *apiServer) Deactivate(ctx context.Context, req *authclient.DeactivateRe
 	}, backoff.RetryEvery(time.Second)); err != nil {
 		return nil, err
 	}
-	time.Sleep(time.Second) // give other pachd nodes time to update their cache
-	return &authclient.DeactivateResponse{}, nil
+	// wait until every other pachd node has updated its cache too
+	if err := a.waitForAdminConvergence(ctx, txnResp.Header.Revision); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 // GitHubTokenToUsername takes a OAuth access token issued by GitHub and uses
@@ -608,6 +1249,8 @@ func GitHubTokenToUsername(ctx context.Context, oauthToken string) (string, erro
 
 	// Retrieve the caller's GitHub Username (the empty string gets us the
 	// authenticated user)
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/auth.github/GetAuthenticatedUser")
+	defer tracing.FinishAnySpan(span)
 	user, _, err := gclient.Users.Get(ctx, "")
 	if err != nil {
 		return "", fmt.Errorf("error getting the authenticated user: %v", err)
@@ -677,28 +1320,12 @@ func (a *apiServer) validateModifyAdminsRequest(add []string, remove []string) e
 func (a *apiServer) ModifyAdmins(ctx context.Context, req *authclient.ModifyAdminsRequest) (resp *authclient.ModifyAdminsResponse, retErr error) {
 	a.LogReq(req)
 	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
-	switch a.activationState() {
-	case none:
-		return nil, authclient.ErrNotActivated
-	case partial:
-		return nil, authclient.ErrPartiallyActivated
-	}
-
-	// Get calling user. The user must be an admin to change the list of admins
+	// activation and admin checks for this RPC run in the auth interceptor
+	// (see authPolicies)
 	callerInfo, err := a.getAuthenticatedUser(ctx)
 	if err != nil {
 		return nil, err
 	}
-	isAdmin, err := a.isAdmin(ctx, callerInfo.Subject)
-	if err != nil {
-		return nil, err
-	}
-	if !isAdmin {
-		return nil, &authclient.ErrNotAuthorized{
-			Subject: callerInfo.Subject,
-			AdminOp: "ModifyAdmins",
-		}
-	}
 
 	// Canonicalize GitHub usernames in request (must canonicalize before we can
 	// validate, so we know who is actually being added/removed & can confirm
@@ -754,13 +1381,20 @@ func (a *apiServer) ModifyAdmins(ctx context.Context, req *authclient.ModifyAdmi
 	if retErr != nil {
 		return nil, retErr
 	}
+	// Notify any config webhooks (ModifyAdmins doesn't change the config, so
+	// the old and new versions are equal)
+	var configVersion int64
+	if cfg := a.getCacheConfig(); cfg != nil {
+		configVersion = cfg.Version
+	}
+	a.notifyChange("ModifyAdmins", callerInfo.Subject, configVersion, configVersion)
 	return &authclient.ModifyAdminsResponse{}, nil
 }
 
 // expiredClusterAdminCheck enforces that if the cluster's enterprise token is
 // expired, only admins may log in.
 func (a *apiServer) expiredClusterAdminCheck(ctx context.Context, username string) error {
-	state, err := a.getEnterpriseTokenState()
+	state, err := a.getEnterpriseTokenState(ctx)
 	if err != nil {
 		return fmt.Errorf("error confirming Pachyderm Enterprise token: %v", err)
 	}
@@ -770,9 +1404,7 @@ func (a *apiServer) expiredClusterAdminCheck(ctx context.Context, username strin
 		return err
 	}
 	if state != enterpriseclient.State_ACTIVE && !isAdmin {
-		return errors.New("Pachyderm Enterprise is not active in this " +
-			"cluster (until Pachyderm Enterprise is re-activated or Pachyderm " +
-			"auth is deactivated, only cluster admins can perform any operations)")
+		return authclient.ErrExpiredEnterprise
 	}
 	return nil
 }
@@ -789,9 +1421,10 @@ func (a *apiServer) Authenticate(ctx context.Context, req *authclient.Authentica
 		return nil, authclient.ErrPartiallyActivated
 	}
 
-	// We don't want to actually log the request/response since they contain
-	// credentials.
-	defer func(start time.Time) { a.LogResp(nil, nil, retErr, time.Since(start)) }(time.Now())
+	// The credentials in the request and response are annotated with (redact)
+	// in auth.proto, so they're scrubbed from the log (see redact.go)
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
 
 	// verify whatever credential the user has presented, and write a new
 	// Pachyderm token for the user that their credential belongs to
@@ -891,9 +1524,10 @@ func (a *apiServer) getCallerTTL(ctx context.Context) (int64, error) {
 
 // GetOneTimePassword implements the protobuf auth.GetOneTimePassword RPC
 func (a *apiServer) GetOneTimePassword(ctx context.Context, req *authclient.GetOneTimePasswordRequest) (resp *authclient.GetOneTimePasswordResponse, retErr error) {
-	// We don't want to actually log the request/response since they contain
-	// credentials.
-	defer func(start time.Time) { a.LogResp(nil, nil, retErr, time.Since(start)) }(time.Now())
+	// The one-time password in the response is annotated with (redact) in
+	// auth.proto, so it's scrubbed from the log (see redact.go)
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
 
 	// Make sure auth is activated
 	switch a.activationState() {
@@ -1046,24 +1680,56 @@ func (a *apiServer) AuthorizeInTransaction(
 
 	// If the cluster's enterprise token is expired, only admins and pipelines may
 	// authorize (and admins are already handled)
-	state, err := a.getEnterpriseTokenState()
+	state, err := a.getEnterpriseTokenState(txnCtx.ClientContext)
 	if err != nil {
 		return nil, fmt.Errorf("error confirming Pachyderm Enterprise token: %v", err)
 	}
 	if state != enterpriseclient.State_ACTIVE &&
 		!strings.HasPrefix(callerInfo.Subject, authclient.PipelinePrefix) {
-		return nil, errors.New("Pachyderm Enterprise is not active in this " +
-			"cluster (until Pachyderm Enterprise is re-activated or Pachyderm " +
-			"auth is deactivated, only cluster admins can perform any operations)")
-	}
-
-	// Get ACL to check
-	var acl authclient.ACL
-	if err := a.acls.ReadWrite(txnCtx.Stm).Get(req.Repo, &acl); err != nil && !col.IsErrNotFound(err) {
-		return nil, fmt.Errorf("error getting ACL for repo \"%s\": %v", req.Repo, err)
+		return nil, authclient.ErrExpiredEnterprise
 	}
 
-	scope, err := a.getScope(txnCtx.ClientContext, callerInfo.Subject, &acl)
+	// Authorization requests for cluster resources (debug dumps, enterprise
+	// RPCs, etc.) are checked against the resource's own ACL rather than a
+	// repo ACL. If no ACL has been set for a resource, only admins (handled
+	// above) may access it
+	if req.Resource != authclient.ClusterResource_REPO {
+		var acl authclient.ACL
+		if err := a.acls.ReadWrite(txnCtx.Stm).Get(clusterResourceKey(req.Resource), &acl); err != nil && !col.IsErrNotFound(err) {
+			return nil, fmt.Errorf("error getting ACL for cluster resource %q: %v", req.Resource, err)
+		}
+		scope, err := a.getScopeAtAuthorize(txnCtx.ClientContext, callerInfo, &acl)
+		if err != nil {
+			return nil, err
+		}
+		return &authclient.AuthorizeResponse{
+			Authorized: scope >= req.Scope,
+		}, nil
+	}
+
+	// If an external authorizer (e.g. an Open Policy Agent sidecar) is
+	// configured, delegate the decision to it instead of consulting the repo's
+	// ACL (admins and the spec repo are already handled above)
+	if config := a.getCacheConfig(); config != nil && config.ExternalAuthorizer != nil {
+		groups, err := a.getGroups(txnCtx.ClientContext, callerInfo.Subject)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve caller's group memberships: %v", err)
+		}
+		authorized, err := externalAuthorize(txnCtx.ClientContext,
+			config.ExternalAuthorizer.Endpoint, callerInfo.Subject, groups, req.Repo, req.Scope)
+		if err != nil {
+			return nil, err
+		}
+		return &authclient.AuthorizeResponse{Authorized: authorized}, nil
+	}
+
+	// Get ACL to check
+	var acl authclient.ACL
+	if err := a.acls.ReadWrite(txnCtx.Stm).Get(req.Repo, &acl); err != nil && !col.IsErrNotFound(err) {
+		return nil, fmt.Errorf("error getting ACL for repo \"%s\": %v", req.Repo, err)
+	}
+
+	scope, err := a.getScopeAtAuthorize(txnCtx.ClientContext, callerInfo, &acl)
 	if err != nil {
 		return nil, err
 	}
@@ -1072,6 +1738,101 @@ func (a *apiServer) AuthorizeInTransaction(
 	}, nil
 }
 
+// AuthorizeBatchInTransaction evaluates authorization for a slice of repos in
+// one call, against a single snapshot of the ACLs (all reads go through
+// txnCtx.Stm). The caller's identity, admin status, and group memberships are
+// resolved once for the whole batch, so callers like PFS's ListRepo don't pay
+// a per-repo cost. The response at index i corresponds to reqs[i]. This is
+// not an RPC.
+func (a *apiServer) AuthorizeBatchInTransaction(
+	txnCtx *txnenv.TransactionContext,
+	reqs []*authclient.AuthorizeRequest,
+) (resps []*authclient.AuthorizeResponse, retErr error) {
+	if a.activationState() == none {
+		return nil, authclient.ErrNotActivated
+	}
+
+	callerInfo, err := a.getAuthenticatedUser(txnCtx.ClientContext)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, err := a.isAdmin(txnCtx.ClientContext, callerInfo.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	// admins are always authorized
+	if isAdmin {
+		resps = make([]*authclient.AuthorizeResponse, len(reqs))
+		for i := range reqs {
+			resps[i] = &authclient.AuthorizeResponse{Authorized: true}
+		}
+		return resps, nil
+	}
+
+	// If the cluster's enterprise token is expired, only admins and pipelines may
+	// authorize (and admins are already handled)
+	state, err := a.getEnterpriseTokenState(txnCtx.ClientContext)
+	if err != nil {
+		return nil, fmt.Errorf("error confirming Pachyderm Enterprise token: %v", err)
+	}
+	if state != enterpriseclient.State_ACTIVE &&
+		!strings.HasPrefix(callerInfo.Subject, authclient.PipelinePrefix) {
+		return nil, authclient.ErrExpiredEnterprise
+	}
+
+	groups, err := a.getGroups(txnCtx.ClientContext, callerInfo.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve caller's group memberships: %v", err)
+	}
+	config := a.getCacheConfig()
+	acls := a.acls.ReadWrite(txnCtx.Stm)
+	resps = make([]*authclient.AuthorizeResponse, 0, len(reqs))
+	for _, req := range reqs {
+		if req.Repo == ppsconsts.SpecRepo {
+			// All users are authorized to read from the spec repo, but only admins
+			// are authorized to write to it
+			resps = append(resps, &authclient.AuthorizeResponse{
+				Authorized: req.Scope == authclient.Scope_READER,
+			})
+			continue
+		}
+		if req.Resource != authclient.ClusterResource_REPO {
+			var acl authclient.ACL
+			if err := acls.Get(clusterResourceKey(req.Resource), &acl); err != nil && !col.IsErrNotFound(err) {
+				return nil, fmt.Errorf("error getting ACL for cluster resource %q: %v", req.Resource, err)
+			}
+			resps = append(resps, &authclient.AuthorizeResponse{
+				Authorized: scopeFromACL(callerInfo, groups, &acl) >= req.Scope,
+			})
+			continue
+		}
+		if config != nil && config.ExternalAuthorizer != nil {
+			authorized, err := externalAuthorize(txnCtx.ClientContext,
+				config.ExternalAuthorizer.Endpoint, callerInfo.Subject, groups, req.Repo, req.Scope)
+			if err != nil {
+				return nil, err
+			}
+			resps = append(resps, &authclient.AuthorizeResponse{Authorized: authorized})
+			continue
+		}
+		var acl authclient.ACL
+		if err := acls.Get(req.Repo, &acl); err != nil && !col.IsErrNotFound(err) {
+			return nil, fmt.Errorf("error getting ACL for repo \"%s\": %v", req.Repo, err)
+		}
+		resps = append(resps, &authclient.AuthorizeResponse{
+			Authorized: scopeFromACL(callerInfo, groups, &acl) >= req.Scope,
+		})
+	}
+	return resps, nil
+}
+
+// clusterResourceKey returns the key in the acls collection under which the
+// ACL for a cluster resource is stored (e.g. "cluster:debug_dump")
+func clusterResourceKey(r authclient.ClusterResource) string {
+	return clusterResourcePrefix + strings.ToLower(r.String())
+}
+
 // Authorize implements the protobuf auth.Authorize RPC
 func (a *apiServer) Authorize(
 	ctx context.Context,
@@ -1095,10 +1856,8 @@ func (a *apiServer) Authorize(
 func (a *apiServer) WhoAmI(ctx context.Context, req *authclient.WhoAmIRequest) (resp *authclient.WhoAmIResponse, retErr error) {
 	a.LogReq(req)
 	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
-	if a.activationState() == none {
-		return nil, authclient.ErrNotActivated
-	}
-
+	// the activation check for this RPC runs in the auth interceptor (see
+	// authPolicies)
 	callerInfo, err := a.getAuthenticatedUser(ctx)
 	if err != nil {
 		return nil, err
@@ -1162,6 +1921,27 @@ func (a *apiServer) isAdmin(ctx context.Context, subject string) (bool, error) {
 	return false, nil
 }
 
+// logACLChange appends a record of an ACL mutation to the repo's ACL history.
+// It must be called from inside the STM in which the mutation itself is
+// written, so that the history entry and the new ACL are updated atomically.
+func (a *apiServer) logACLChange(stm col.STM, repo, modifiedBy string, oldACL, newACL *authclient.ACL) error {
+	modifiedAt, err := types.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	var history authclient.ACLHistory
+	return a.aclHistory.ReadWrite(stm).Upsert(repo, &history, func() error {
+		history.Changes = append(history.Changes, &authclient.ACLChange{
+			Repo:       repo,
+			ModifiedBy: modifiedBy,
+			ModifiedAt: modifiedAt,
+			OldACL:     oldACL,
+			NewACL:     newACL,
+		})
+		return nil
+	})
+}
+
 // SetScopeInTransaction is identical to SetScope except that it can run inside
 // an existing etcd STM transaction.  This is not an RPC.
 func (a *apiServer) SetScopeInTransaction(
@@ -1185,22 +1965,36 @@ func (a *apiServer) SetScopeInTransaction(
 		return nil, err
 	}
 
+	// Keys under the reserved "cluster:" prefix are ACLs for cluster resources
+	// (see AuthorizeRequest.resource) rather than repos, and may only be
+	// modified by cluster admins
+	isClusterResource := strings.HasPrefix(req.Repo, clusterResourcePrefix)
+	if isClusterResource && !isAdmin {
+		return nil, &authclient.ErrNotAuthorized{
+			Subject: callerInfo.Subject,
+			AdminOp: "SetScope on a cluster resource's ACL",
+		}
+	}
+
 	acls := a.acls.ReadWrite(txnCtx.Stm)
 	var acl authclient.ACL
 	if err := acls.Get(req.Repo, &acl); err != nil {
 		if !col.IsErrNotFound(err) {
 			return nil, err
 		}
-		// ACL not found. Check that repo exists (return error if not).
-		_, err = txnCtx.Pfs().InspectRepoInTransaction(
-			txnCtx,
-			&pfs.InspectRepoRequest{Repo: &pfs.Repo{Name: req.Repo}},
-		)
-		if err != nil {
-			return nil, err
+		if !isClusterResource {
+			// ACL not found. Check that repo exists (return error if not).
+			_, err = txnCtx.Pfs().InspectRepoInTransaction(
+				txnCtx,
+				&pfs.InspectRepoRequest{Repo: &pfs.Repo{Name: req.Repo}},
+			)
+			if err != nil {
+				return nil, err
+			}
 		}
 
-		// Repo exists, but has no ACL. Create default (empty) ACL
+		// Repo exists (or this is a cluster resource), but has no ACL. Create
+		// default (empty) ACL
 		acl.Entries = make(map[string]authclient.Scope)
 	}
 
@@ -1212,13 +2006,12 @@ func (a *apiServer) SetScopeInTransaction(
 		}
 
 		// Check if the cluster's enterprise token is expired (fail if so)
-		state, err := a.getEnterpriseTokenState()
+		state, err := a.getEnterpriseTokenState(txnCtx.ClientContext)
 		if err != nil {
 			return false, fmt.Errorf("error confirming Pachyderm Enterprise token: %v", err)
 		}
 		if state != enterpriseclient.State_ACTIVE {
-			return false, fmt.Errorf("Pachyderm Enterprise is not active in this " +
-				"cluster (only a cluster admin can set a scope)")
+			return false, authclient.ErrExpiredEnterprise
 		}
 
 		// Check if the user or one of their groups is on the ACL directly
@@ -1247,10 +2040,45 @@ func (a *apiServer) SetScopeInTransaction(
 	if err != nil {
 		return nil, err
 	}
+	// Protect a repo's only OWNER from accidentally revoking their own access
+	// and orphaning the repo (mirroring the check that prevents ModifyAdmins
+	// from removing all cluster admins). Removing or demoting your own OWNER
+	// entry is rejected unless another OWNER remains on the ACL or req.Force
+	// is set
+	if !req.Force && principal == callerInfo.Subject &&
+		acl.Entries[principal] == authclient.Scope_OWNER &&
+		req.Scope < authclient.Scope_OWNER {
+		var otherOwner bool
+		for user, scope := range acl.Entries {
+			if user != principal && scope == authclient.Scope_OWNER {
+				otherOwner = true
+				break
+			}
+		}
+		if !otherOwner {
+			return nil, fmt.Errorf("invalid request: cannot remove the only OWNER "+
+				"of repo \"%s\" (this would leave the repo with no owner); add "+
+				"another OWNER first, or set force to override", req.Repo)
+		}
+	}
+
+	oldACL := proto.Clone(&acl).(*authclient.ACL) // copy previous ACL for history
 	if req.Scope != authclient.Scope_NONE {
 		acl.Entries[principal] = req.Scope
+		if req.Conditions != nil {
+			if err := validateACLConditions(req.Conditions); err != nil {
+				return nil, err
+			}
+			if acl.Conditions == nil {
+				acl.Conditions = make(map[string]*authclient.ACLConditions)
+			}
+			acl.Conditions[principal] = req.Conditions
+		} else {
+			delete(acl.Conditions, principal)
+		}
 	} else {
 		delete(acl.Entries, principal)
+		delete(acl.Conditions, principal)
 	}
 	if len(acl.Entries) == 0 {
 		err = acls.Delete(req.Repo)
@@ -1260,6 +2088,9 @@ func (a *apiServer) SetScopeInTransaction(
 	if err != nil {
 		return nil, err
 	}
+	if err := a.logACLChange(txnCtx.Stm, req.Repo, callerInfo.Subject, oldACL, &acl); err != nil {
+		return nil, err
+	}
 	return &authclient.SetScopeResponse{}, nil
 }
 
@@ -1279,6 +2110,92 @@ func (a *apiServer) SetScope(ctx context.Context, req *authclient.SetScopeReques
 	return response, nil
 }
 
+// validateACLConditions checks that ACL entry conditions attached to a
+// SetScope/SetACL request are well-formed before they're stored
+func validateACLConditions(cond *authclient.ACLConditions) error {
+	if (cond.StartTime == "") != (cond.EndTime == "") {
+		return fmt.Errorf("invalid ACL conditions: start_time and end_time must be set together")
+	}
+	if cond.StartTime != "" {
+		if _, err := time.Parse("15:04", cond.StartTime); err != nil {
+			return fmt.Errorf("invalid ACL conditions: could not parse start_time %q (must be \"HH:MM\"): %v", cond.StartTime, err)
+		}
+		if _, err := time.Parse("15:04", cond.EndTime); err != nil {
+			return fmt.Errorf("invalid ACL conditions: could not parse end_time %q (must be \"HH:MM\"): %v", cond.EndTime, err)
+		}
+	}
+	return nil
+}
+
+// evaluateACLConditions reports whether the conditions attached to an ACL
+// entry are met by the caller's current request context. 'groups' is the set
+// of groups that the caller belongs to, and 'now' is the evaluation time
+// (passed in for testability)
+func evaluateACLConditions(cond *authclient.ACLConditions, callerInfo *authclient.TokenInfo, groups map[string]bool, now time.Time) bool {
+	if cond.StartTime != "" {
+		// times were validated when the conditions were stored
+		start, startErr := time.Parse("15:04", cond.StartTime)
+		end, endErr := time.Parse("15:04", cond.EndTime)
+		if startErr != nil || endErr != nil {
+			return false // unparseable window grants no access
+		}
+		startMins := start.Hour()*60 + start.Minute()
+		endMins := end.Hour()*60 + end.Minute()
+		nowMins := now.UTC().Hour()*60 + now.UTC().Minute()
+		if startMins <= endMins {
+			if nowMins < startMins || nowMins >= endMins {
+				return false
+			}
+		} else if nowMins < startMins && nowMins >= endMins {
+			// window wraps around midnight
+			return false
+		}
+	}
+	if cond.RequiredGroup != "" && !groups[cond.RequiredGroup] {
+		return false
+	}
+	if cond.TokenSource != authclient.TokenInfo_INVALID && cond.TokenSource != callerInfo.Source {
+		return false
+	}
+	return true
+}
+
+// getScopeAtAuthorize is like getScope, but evaluates any conditions attached
+// to the matching ACL entries (time-of-day window, required group, token
+// source) against the caller's current request context, skipping entries
+// whose conditions aren't met. It's only used by Authorize--GetScope reports
+// a principal's unconditioned access level
+func (a *apiServer) getScopeAtAuthorize(ctx context.Context, callerInfo *authclient.TokenInfo, acl *authclient.ACL) (authclient.Scope, error) {
+	groups, err := a.getGroups(ctx, callerInfo.Subject)
+	if err != nil {
+		return authclient.Scope_NONE, fmt.Errorf("could not retrieve caller's "+
+			"group memberships: %v", err)
+	}
+	return scopeFromACL(callerInfo, groups, acl), nil
+}
+
+// scopeFromACL returns the scope that 'acl' grants to the caller, either
+// directly or through one of their groups (taking any ACL conditions into
+// account). It's shared by getScopeAtAuthorize and the batch authorization
+// path, which fetches the caller's groups once for many ACLs.
+func scopeFromACL(callerInfo *authclient.TokenInfo, groups []string, acl *authclient.ACL) authclient.Scope {
+	groupSet := addToSet(nil, groups...)
+	var scope authclient.Scope
+	now := time.Now()
+	for _, principal := range append([]string{callerInfo.Subject}, groups...) {
+		entryScope, ok := acl.Entries[principal]
+		if !ok || entryScope <= scope {
+			continue
+		}
+		if cond := acl.Conditions[principal]; cond != nil &&
+			!evaluateACLConditions(cond, callerInfo, groupSet, now) {
+			continue
+		}
+		scope = entryScope
+	}
+	return scope
+}
+
 // getScope is a helper function for the GetScope GRPC API, as well is
 // Authorized() and other authorization checks (e.g. checking if a user is an
 // OWNER to determine if they can modify an ACL).
@@ -1323,14 +2240,12 @@ func (a *apiServer) GetScopeInTransaction(
 	// Check if the cluster's enterprise token is expired (fail if so)
 	// Note: this is duplicated from a.expiredClusterAdminCheck, but we need the
 	// admin information elsewhere, so the code is copied here
-	state, err := a.getEnterpriseTokenState()
+	state, err := a.getEnterpriseTokenState(txnCtx.ClientContext)
 	if err != nil {
 		return nil, fmt.Errorf("error confirming Pachyderm Enterprise token: %v", err)
 	}
 	if state != enterpriseclient.State_ACTIVE && !callerIsAdmin {
-		return nil, errors.New("Pachyderm Enterprise is not active in this " +
-			"cluster (until Pachyderm Enterprise is re-activated or Pachyderm " +
-			"auth is deactivated, only cluster admins can perform any operations)")
+		return nil, authclient.ErrExpiredEnterprise
 	}
 
 	// If the caller is getting another user's scopes, the caller must have
@@ -1369,10 +2284,11 @@ func (a *apiServer) GetScopeInTransaction(
 				return nil, err
 			}
 			if callerScope < authclient.Scope_READER {
-				return nil, &authclient.ErrNotAuthorized{
+				return nil, &authclient.ErrInsufficientScope{
 					Subject:  callerInfo.Subject,
 					Repo:     repo,
 					Required: authclient.Scope_READER,
+					Actual:   callerScope,
 				}
 			}
 		}
@@ -1433,17 +2349,42 @@ func (a *apiServer) GetACLInTransaction(
 	if err = a.acls.ReadWrite(txnCtx.Stm).Get(req.Repo, acl); err != nil && !col.IsErrNotFound(err) {
 		return nil, err
 	}
+
+	// By default, no access is required to read a repo's ACL
+	// (https://github.com/pachyderm/pachyderm/issues/2353), but clusters can
+	// opt in to requiring READER access on the repo via the auth config.
+	// Admins can always read any repo's ACL
+	if config := a.getCacheConfig(); config != nil && config.GetACLRequiresReader {
+		isAdmin, err := a.isAdmin(txnCtx.ClientContext, callerInfo.Subject)
+		if err != nil {
+			return nil, err
+		}
+		if !isAdmin {
+			callerScope, err := a.getScope(txnCtx.ClientContext, callerInfo.Subject, acl)
+			if err != nil {
+				return nil, err
+			}
+			if callerScope < authclient.Scope_READER {
+				return nil, &authclient.ErrInsufficientScope{
+					Subject:  callerInfo.Subject,
+					Repo:     req.Repo,
+					Required: authclient.Scope_READER,
+					Actual:   callerScope,
+				}
+			}
+		}
+	}
+
 	response := &authclient.GetACLResponse{
 		Entries: make([]*authclient.ACLEntry, 0),
 	}
 	for user, scope := range acl.Entries {
 		response.Entries = append(response.Entries, &authclient.ACLEntry{
-			Username: user,
-			Scope:    scope,
+			Username:   user,
+			Scope:      scope,
+			Conditions: acl.Conditions[user],
 		})
 	}
-	// For now, no access is require to read a repo's ACL
-	// https://github.com/pachyderm/pachyderm/issues/2353
 	return response, nil
 }
 
@@ -1488,6 +2429,15 @@ func (a *apiServer) SetACLInTransaction(
 		return nil, err
 	}
 
+	// ACLs for cluster resources (keys under the reserved "cluster:" prefix;
+	// see AuthorizeRequest.resource) may only be modified by cluster admins
+	if strings.HasPrefix(req.Repo, clusterResourcePrefix) && !isAdmin {
+		return nil, &authclient.ErrNotAuthorized{
+			Subject: callerInfo.Subject,
+			AdminOp: "SetACL on a cluster resource's ACL",
+		}
+	}
+
 	// Canonicalize entries in the request (must have canonical request before we
 	// can authorize, as we inspect the ACL contents during authorization in the
 	// case where we're creating a new repo)
@@ -1498,10 +2448,15 @@ func (a *apiServer) SetACLInTransaction(
 		newACL.Entries = make(map[string]authclient.Scope)
 	}
 	for _, entry := range req.Entries {
-		user, scope := entry.Username, entry.Scope
+		user, scope, conditions := entry.Username, entry.Scope, entry.Conditions
 		if user == magicUser {
 			continue
 		}
+		if conditions != nil {
+			if err := validateACLConditions(conditions); err != nil {
+				return nil, err
+			}
+		}
 		eg.Go(func() error {
 			principal, err := a.canonicalizeSubject(txnCtx.ClientContext, user)
 			if err != nil {
@@ -1510,6 +2465,12 @@ func (a *apiServer) SetACLInTransaction(
 			aclMu.Lock()
 			defer aclMu.Unlock()
 			newACL.Entries[principal] = scope
+			if conditions != nil {
+				if newACL.Conditions == nil {
+					newACL.Conditions = make(map[string]*authclient.ACLConditions)
+				}
+				newACL.Conditions[principal] = conditions
+			}
 			return nil
 		})
 	}
@@ -1528,13 +2489,12 @@ func (a *apiServer) SetACLInTransaction(
 		}
 
 		// Check if the cluster's enterprise token is expired (fail if so)
-		state, err := a.getEnterpriseTokenState()
+		state, err := a.getEnterpriseTokenState(txnCtx.ClientContext)
 		if err != nil {
 			return false, fmt.Errorf("error confirming Pachyderm Enterprise token: %v", err)
 		}
 		if state != enterpriseclient.State_ACTIVE {
-			return false, fmt.Errorf("Pachyderm Enterprise is not active in this " +
-				"cluster (only a cluster admin can modify an ACL)")
+			return false, authclient.ErrExpiredEnterprise
 		}
 
 		// Check if there is an existing ACL, and if the user is on it
@@ -1583,6 +2543,13 @@ func (a *apiServer) SetACLInTransaction(
 		}
 	}
 
+	// Read the previous ACL, so that this change can be recorded in the repo's
+	// ACL history
+	var prevACL authclient.ACL
+	if err := acls.Get(req.Repo, &prevACL); err != nil && !col.IsErrNotFound(err) {
+		return nil, err
+	}
+
 	// Set new ACL
 	if len(newACL.Entries) == 0 {
 		err := acls.Delete(req.Repo)
@@ -1595,6 +2562,9 @@ func (a *apiServer) SetACLInTransaction(
 			return nil, fmt.Errorf("could not put new ACL: %v", err)
 		}
 	}
+	if err := a.logACLChange(txnCtx.Stm, req.Repo, callerInfo.Subject, &prevACL, newACL); err != nil {
+		return nil, err
+	}
 	return &authclient.SetACLResponse{}, nil
 }
 
@@ -1614,22 +2584,19 @@ func (a *apiServer) SetACL(ctx context.Context, req *authclient.SetACLRequest) (
 	return response, nil
 }
 
-// GetAuthToken implements the protobuf auth.GetAuthToken RPC
-func (a *apiServer) GetAuthToken(ctx context.Context, req *authclient.GetAuthTokenRequest) (resp *authclient.GetAuthTokenResponse, retErr error) {
+// GetACLHistory implements the protobuf auth.GetACLHistory RPC
+func (a *apiServer) GetACLHistory(ctx context.Context, req *authclient.GetACLHistoryRequest) (resp *authclient.GetACLHistoryResponse, retErr error) {
 	a.LogReq(req)
 	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
 	if a.activationState() == none {
 		return nil, authclient.ErrNotActivated
 	}
-	if req.Subject == "" {
-		return nil, fmt.Errorf("must set GetAuthTokenRequest.Subject")
-	}
-	if req.Subject == magicUser {
-		return nil, fmt.Errorf("GetAuthTokenRequest.Subject is invalid")
+	if req.Repo == "" {
+		return nil, fmt.Errorf("invalid request: must provide name of repo to get that repo's ACL history")
 	}
 
-	// Get current caller and authorize them if req.Subject is set to a different
-	// user
+	// Get calling user. Unlike GetACL, the history shows who made each change,
+	// so the caller must be an OWNER of the repo (or an admin) to read it
 	callerInfo, err := a.getAuthenticatedUser(ctx)
 	if err != nil {
 		return nil, err
@@ -1638,83 +2605,47 @@ func (a *apiServer) GetAuthToken(ctx context.Context, req *authclient.GetAuthTok
 	if err != nil {
 		return nil, err
 	}
-
-	// TODO(msteffen): This code is duplicated here and in GetOneTimePassword.
-	// These two RPC handlers should probably be unified
-	// Canonicalize req.Subject, authorize caller
-	if req.Subject == "" {
-		// [Simple case] caller wants an implicit OTP for themselves
-		// Canonicalization: callerInfo.Subject is already canonical.
-		// Authorization: Getting an OTP for yourself is always authorized
-		// TTL: Use caller's current token if non-admin, default OTP TTL otherwise
-		// NOTE: After this point, req.Subject may be magicUser (even though we
-		// reject magicUser when set explicitly
-		req.Subject = callerInfo.Subject
-	} else {
-		// [Harder case] explicit req.Subject
-		// canonicalize req.Subject
-		req.Subject, err = a.canonicalizeSubject(ctx, req.Subject)
-		if err != nil {
+	if !isAdmin {
+		var acl authclient.ACL
+		if err := a.acls.ReadOnly(ctx).Get(req.Repo, &acl); err != nil && !col.IsErrNotFound(err) {
 			return nil, err
 		}
-
-		// Authorization: caller must be admin to get OTP for another user
-		if !isAdmin && req.Subject != callerInfo.Subject {
-			return nil, &authclient.ErrNotAuthorized{
-				Subject: callerInfo.Subject,
-				AdminOp: "GetOneTimePassword on behalf of another user",
-			}
-		}
-	}
-
-	// Compute TTL for new token that the user will get once OTP is exchanged
-	// Note: Admins always use default TTL (30 days currently), which means they
-	// can extend their session by getting an OTP and exchanging it for a new
-	// token with a later expiration than their curren token
-	var ttl = int64(defaultTokenTTLSecs)
-	if !isAdmin {
-		// Caller is getting OTP for themselves--use TTL of their current token
-		ttl, err = a.getCallerTTL(ctx)
+		scope, err := a.getScope(ctx, callerInfo.Subject, &acl)
 		if err != nil {
 			return nil, err
 		}
-		if req.TTL == 0 || req.TTL > ttl {
-			req.TTL = ttl
+		if scope < authclient.Scope_OWNER {
+			return nil, &authclient.ErrInsufficientScope{
+				Subject:  callerInfo.Subject,
+				Repo:     req.Repo,
+				Required: authclient.Scope_OWNER,
+				Actual:   scope,
+			}
 		}
 	}
-	tokenInfo := authclient.TokenInfo{
-		Source:  authclient.TokenInfo_GET_TOKEN,
-		Subject: req.Subject,
-	}
 
-	// generate new token, and write to etcd
-	token := uuid.NewWithoutDashes()
-	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
-		return a.tokens.ReadWrite(stm).PutTTL(hashToken(token), &tokenInfo, req.TTL)
-	}); err != nil {
-		if tokenInfo.Subject != magicUser {
-			return nil, fmt.Errorf("error storing token for user \"%s\": %v", tokenInfo.Subject, err)
-		}
-		return nil, fmt.Errorf("error storing token: %v", err)
+	var history authclient.ACLHistory
+	if err := a.aclHistory.ReadOnly(ctx).Get(req.Repo, &history); err != nil && !col.IsErrNotFound(err) {
+		return nil, err
 	}
-	return &authclient.GetAuthTokenResponse{
-		Subject: req.Subject,
-		Token:   token,
+	return &authclient.GetACLHistoryResponse{
+		Changes: history.Changes,
 	}, nil
 }
 
-// ExtendAuthToken implements the protobuf auth.ExtendAuthToken RPC
-func (a *apiServer) ExtendAuthToken(ctx context.Context, req *authclient.ExtendAuthTokenRequest) (resp *authclient.ExtendAuthTokenResponse, retErr error) {
+// RestoreACL implements the protobuf auth.RestoreACL RPC
+func (a *apiServer) RestoreACL(ctx context.Context, req *authclient.RestoreACLRequest) (resp *authclient.RestoreACLResponse, retErr error) {
 	a.LogReq(req)
 	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
-	if a.activationState() != full {
+	if a.activationState() == none {
 		return nil, authclient.ErrNotActivated
 	}
-	if req.TTL == 0 {
-		return nil, fmt.Errorf("invalid request: ExtendAuthTokenRequest.TTL must be > 0")
+	if req.Repo == "" {
+		return nil, fmt.Errorf("invalid request: must provide name of repo whose ACL you want to restore")
 	}
 
-	// Only admins can extend auth tokens (for now)
+	// Get calling user. The caller must be an OWNER of the repo (or an admin)
+	// to restore its ACL
 	callerInfo, err := a.getAuthenticatedUser(ctx)
 	if err != nil {
 		return nil, err
@@ -1723,64 +2654,948 @@ func (a *apiServer) ExtendAuthToken(ctx context.Context, req *authclient.ExtendA
 	if err != nil {
 		return nil, err
 	}
-	if !isAdmin {
-		return nil, &authclient.ErrNotAuthorized{
-			Subject: callerInfo.Subject,
-			AdminOp: "ExtendAuthToken",
-		}
-	}
-
-	// Only let people extend tokens by up to 30 days (the equivalent of logging
-	// in again)
-	if req.TTL > defaultTokenTTLSecs {
-		return nil, fmt.Errorf("can only extend tokens by at most %d seconds", defaultTokenTTLSecs)
-	}
 
-	// The token must already exist. If a token has been revoked, it can't be
-	// extended
 	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
-		tokens := a.tokens.ReadWrite(stm)
+		acls := a.acls.ReadWrite(stm)
 
-		// Actually look up the request token in the relevant collections
-		var tokenInfo authclient.TokenInfo
+		// Read the repo's current ACL (used both to authorize the caller and as
+		// the "before" state of the restore's own history entry)
+		var acl authclient.ACL
+		if err := acls.Get(req.Repo, &acl); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		if !isAdmin {
+			scope, err := a.getScope(ctx, callerInfo.Subject, &acl)
+			if err != nil {
+				return err
+			}
+			if scope < authclient.Scope_OWNER {
+				return &authclient.ErrInsufficientScope{
+					Subject:  callerInfo.Subject,
+					Repo:     req.Repo,
+					Required: authclient.Scope_OWNER,
+					Actual:   scope,
+				}
+			}
+		}
+
+		// Find the requested history entry & restore its "before" state
+		var history authclient.ACLHistory
+		if err := a.aclHistory.ReadWrite(stm).Get(req.Repo, &history); err != nil {
+			return err
+		}
+		if req.Version < 0 || req.Version >= int64(len(history.Changes)) {
+			return fmt.Errorf("invalid request: version %d is outside the repo's "+
+				"ACL history (%d recorded changes)", req.Version, len(history.Changes))
+		}
+		restoredACL := history.Changes[req.Version].OldACL
+		if restoredACL == nil || len(restoredACL.Entries) == 0 {
+			if err := acls.Delete(req.Repo); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+		} else if err := acls.Put(req.Repo, restoredACL); err != nil {
+			return err
+		}
+
+		// The restore itself is an ACL mutation--record it too
+		return a.logACLChange(stm, req.Repo, callerInfo.Subject, &acl, restoredACL)
+	}); err != nil {
+		return nil, err
+	}
+	return &authclient.RestoreACLResponse{}, nil
+}
+
+// explainScope builds the AccessExplanation for 'subject's access to a repo
+// whose ACL is 'acl' (pass an empty ACL if the repo has none)
+func (a *apiServer) explainScope(ctx context.Context, subject string, acl *authclient.ACL) (*authclient.AccessExplanation, error) {
+	explanation := &authclient.AccessExplanation{Subject: subject}
+	isAdmin, err := a.isAdmin(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	explanation.IsAdmin = isAdmin
+	explanation.DirectScope = acl.Entries[subject]
+	groups, err := a.getGroups(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range groups {
+		if groupScope := acl.Entries[g]; groupScope != authclient.Scope_NONE {
+			if explanation.GroupScopes == nil {
+				explanation.GroupScopes = make(map[string]authclient.Scope)
+			}
+			explanation.GroupScopes[g] = groupScope
+		}
+	}
+	explanation.Scope = explanation.DirectScope
+	for _, groupScope := range explanation.GroupScopes {
+		if explanation.Scope < groupScope {
+			explanation.Scope = groupScope
+		}
+	}
+	if isAdmin && explanation.Scope < authclient.Scope_OWNER {
+		explanation.Scope = authclient.Scope_OWNER
+	}
+	return explanation, nil
+}
+
+// ExplainScope implements the protobuf auth.ExplainScope RPC
+func (a *apiServer) ExplainScope(ctx context.Context, req *authclient.ExplainScopeRequest) (resp *authclient.ExplainScopeResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() != full {
+		return nil, authclient.ErrNotActivated
+	}
+	if req.Repo == "" {
+		return nil, fmt.Errorf("must set ExplainScopeRequest.Repo")
+	}
+
+	// Users can explain their own access, and admins can explain any
+	// principal's access
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	callerIsAdmin, err := a.isAdmin(ctx, callerInfo.Subject)
+	if err != nil {
+		return nil, err
+	}
+	subject := callerInfo.Subject
+	if req.Username != "" {
+		subject, err = a.canonicalizeSubject(ctx, req.Username)
+		if err != nil {
+			return nil, err
+		}
+		if !callerIsAdmin && subject != callerInfo.Subject {
+			return nil, &authclient.ErrNotAuthorized{
+				Subject: callerInfo.Subject,
+				AdminOp: "ExplainScope on another user",
+			}
+		}
+	}
+	if subject == magicUser {
+		return nil, fmt.Errorf("ExplainScopeRequest.Username is invalid")
+	}
+
+	var acl authclient.ACL
+	if err := a.acls.ReadOnly(ctx).Get(req.Repo, &acl); err != nil && !col.IsErrNotFound(err) {
+		return nil, err
+	}
+	explanation, err := a.explainScope(ctx, subject, &acl)
+	if err != nil {
+		return nil, err
+	}
+	return &authclient.ExplainScopeResponse{Explanation: explanation}, nil
+}
+
+// WhoCanAccess implements the protobuf auth.WhoCanAccess RPC
+func (a *apiServer) WhoCanAccess(ctx context.Context, req *authclient.WhoCanAccessRequest) (resp *authclient.WhoCanAccessResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() != full {
+		return nil, authclient.ErrNotActivated
+	}
+	if req.Repo == "" {
+		return nil, fmt.Errorf("must set WhoCanAccessRequest.Repo")
+	}
+
+	// Enumerating everybody with access to a repo is at least as sensitive as
+	// reading its ACL, so the caller must own the repo or be an admin
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	callerIsAdmin, err := a.isAdmin(ctx, callerInfo.Subject)
+	if err != nil {
+		return nil, err
+	}
+	var acl authclient.ACL
+	if err := a.acls.ReadOnly(ctx).Get(req.Repo, &acl); err != nil && !col.IsErrNotFound(err) {
+		return nil, err
+	}
+	if !callerIsAdmin {
+		callerScope, err := a.getScope(ctx, callerInfo.Subject, &acl)
+		if err != nil {
+			return nil, err
+		}
+		if callerScope < authclient.Scope_OWNER {
+			return nil, &authclient.ErrNotAuthorized{
+				Subject:  callerInfo.Subject,
+				Repo:     req.Repo,
+				Required: authclient.Scope_OWNER,
+			}
+		}
+	}
+
+	// Principals whose access is below 'threshold' are omitted (NONE would
+	// include every user in the cluster, so it's treated as READER)
+	threshold := req.Scope
+	if threshold == authclient.Scope_NONE {
+		threshold = authclient.Scope_READER
+	}
+
+	// Collect candidate principals: direct ACL entries, members of groups
+	// with ACL entries, and cluster admins
+	candidates := make(map[string]bool)
+	groupsRO := a.groups.ReadOnly(ctx)
+	for entry := range acl.Entries {
+		var membersProto authclient.Users
+		if err := groupsRO.Get(entry, &membersProto); err != nil {
+			if !col.IsErrNotFound(err) {
+				return nil, err
+			}
+			// not a group--the entry is itself a principal
+			candidates[entry] = true
+			continue
+		}
+		for member := range membersProto.Usernames {
+			candidates[member] = true
+		}
+	}
+	func() {
+		a.adminMu.Lock()
+		defer a.adminMu.Unlock()
+		for admin := range a.adminCache {
+			candidates[admin] = true
+		}
+	}()
+	delete(candidates, magicUser)
+
+	response := &authclient.WhoCanAccessResponse{}
+	for subject := range candidates {
+		explanation, err := a.explainScope(ctx, subject, &acl)
+		if err != nil {
+			return nil, err
+		}
+		if explanation.Scope < threshold {
+			continue
+		}
+		response.Explanations = append(response.Explanations, explanation)
+	}
+	// Sort the explanations so that the output is deterministic
+	sort.Slice(response.Explanations, func(i, j int) bool {
+		return response.Explanations[i].Subject < response.Explanations[j].Subject
+	})
+	return response, nil
+}
+
+// GetAuthToken implements the protobuf auth.GetAuthToken RPC
+func (a *apiServer) GetAuthToken(ctx context.Context, req *authclient.GetAuthTokenRequest) (resp *authclient.GetAuthTokenResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() == none {
+		return nil, authclient.ErrNotActivated
+	}
+	if req.Subject == "" {
+		return nil, fmt.Errorf("must set GetAuthTokenRequest.Subject")
+	}
+	if req.Subject == magicUser {
+		return nil, fmt.Errorf("GetAuthTokenRequest.Subject is invalid")
+	}
+
+	// Get current caller and authorize them if req.Subject is set to a different
+	// user
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, err := a.isAdmin(ctx, callerInfo.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO(msteffen): This code is duplicated here and in GetOneTimePassword.
+	// These two RPC handlers should probably be unified
+	// Canonicalize req.Subject, authorize caller
+	if req.Subject == "" {
+		// [Simple case] caller wants an implicit OTP for themselves
+		// Canonicalization: callerInfo.Subject is already canonical.
+		// Authorization: Getting an OTP for yourself is always authorized
+		// TTL: Use caller's current token if non-admin, default OTP TTL otherwise
+		// NOTE: After this point, req.Subject may be magicUser (even though we
+		// reject magicUser when set explicitly
+		req.Subject = callerInfo.Subject
+	} else {
+		// [Harder case] explicit req.Subject
+		// canonicalize req.Subject
+		req.Subject, err = a.canonicalizeSubject(ctx, req.Subject)
+		if err != nil {
+			return nil, err
+		}
+
+		// Authorization: caller must be admin to get OTP for another user
+		if !isAdmin && req.Subject != callerInfo.Subject {
+			return nil, &authclient.ErrNotAuthorized{
+				Subject: callerInfo.Subject,
+				AdminOp: "GetOneTimePassword on behalf of another user",
+			}
+		}
+	}
+
+	// Compute TTL for new token that the user will get once OTP is exchanged
+	// Note: Admins always use default TTL (30 days currently), which means they
+	// can extend their session by getting an OTP and exchanging it for a new
+	// token with a later expiration than their curren token
+	var ttl = int64(defaultTokenTTLSecs)
+	if !isAdmin {
+		// Caller is getting OTP for themselves--use TTL of their current token
+		ttl, err = a.getCallerTTL(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if req.TTL == 0 || req.TTL > ttl {
+			req.TTL = ttl
+		}
+	}
+	tokenInfo := authclient.TokenInfo{
+		Source:  authclient.TokenInfo_GET_TOKEN,
+		Subject: req.Subject,
+	}
+
+	// generate new token, and write to etcd
+	token := uuid.NewWithoutDashes()
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		return a.tokens.ReadWrite(stm).PutTTL(hashToken(token), &tokenInfo, req.TTL)
+	}); err != nil {
+		if tokenInfo.Subject != magicUser {
+			return nil, fmt.Errorf("error storing token for user \"%s\": %v", tokenInfo.Subject, err)
+		}
+		return nil, fmt.Errorf("error storing token: %v", err)
+	}
+	return &authclient.GetAuthTokenResponse{
+		Subject: req.Subject,
+		Token:   token,
+	}, nil
+}
+
+// ExtendAuthToken implements the protobuf auth.ExtendAuthToken RPC
+func (a *apiServer) ExtendAuthToken(ctx context.Context, req *authclient.ExtendAuthTokenRequest) (resp *authclient.ExtendAuthTokenResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() != full {
+		return nil, authclient.ErrNotActivated
+	}
+	if req.TTL == 0 {
+		return nil, fmt.Errorf("invalid request: ExtendAuthTokenRequest.TTL must be > 0")
+	}
+
+	// Only admins can extend auth tokens (for now)
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, err := a.isAdmin(ctx, callerInfo.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, &authclient.ErrNotAuthorized{
+			Subject: callerInfo.Subject,
+			AdminOp: "ExtendAuthToken",
+		}
+	}
+
+	// Only let people extend tokens by up to 30 days (the equivalent of logging
+	// in again)
+	if req.TTL > defaultTokenTTLSecs {
+		return nil, fmt.Errorf("can only extend tokens by at most %d seconds", defaultTokenTTLSecs)
+	}
+
+	// The token must already exist. If a token has been revoked, it can't be
+	// extended
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		tokens := a.tokens.ReadWrite(stm)
+
+		// Actually look up the request token in the relevant collections
+		var tokenInfo authclient.TokenInfo
 		if err := tokens.Get(hashToken(req.Token), &tokenInfo); err != nil && !col.IsErrNotFound(err) {
 			return err
 		}
-		if tokenInfo.Subject == "" {
-			return authclient.ErrBadToken
+		if tokenInfo.Subject == "" {
+			return authclient.ErrBadToken
+		}
+
+		ttl, err := tokens.TTL(hashToken(req.Token))
+		if err != nil {
+			return fmt.Errorf("Error looking up TTL for token: %v", err)
+		}
+		// TODO(msteffen): ttl may be -1 if the token has no TTL. We deliberately do
+		// not check this case so that admins can put TTLs on tokens that don't have
+		// them (otherwise any attempt to do so would get ErrTooShortTTL), but that
+		// decision may be revised
+		if req.TTL < ttl {
+			return authclient.ErrTooShortTTL{
+				RequestTTL:  req.TTL,
+				ExistingTTL: ttl,
+			}
+		}
+		return tokens.PutTTL(hashToken(req.Token), &tokenInfo, req.TTL)
+	}); err != nil {
+		return nil, err
+	}
+	return &authclient.ExtendAuthTokenResponse{}, nil
+}
+
+// RevokeAuthToken implements the protobuf auth.RevokeAuthToken RPC
+func (a *apiServer) RevokeAuthToken(ctx context.Context, req *authclient.RevokeAuthTokenRequest) (resp *authclient.RevokeAuthTokenResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() != full {
+		return nil, authclient.ErrNotActivated
+	}
+
+	// Get the caller. Users can revoke their own tokens, and admins can revoke
+	// any user's token
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, err := a.isAdmin(ctx, callerInfo.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		tokens := a.tokens.ReadWrite(stm)
+		var tokenInfo authclient.TokenInfo
+		if err := tokens.Get(hashToken(req.Token), &tokenInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if !isAdmin && tokenInfo.Subject != callerInfo.Subject {
+			return &authclient.ErrNotAuthorized{
+				Subject: callerInfo.Subject,
+				AdminOp: "RevokeAuthToken on another user's token",
+			}
+		}
+		return tokens.Delete(hashToken(req.Token))
+	}); err != nil {
+		return nil, err
+	}
+	return &authclient.RevokeAuthTokenResponse{}, nil
+}
+
+// ListTokens implements the protobuf auth.ListTokens RPC
+func (a *apiServer) ListTokens(ctx context.Context, req *authclient.ListTokensRequest) (resp *authclient.ListTokensResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() != full {
+		return nil, authclient.ErrNotActivated
+	}
+
+	// Tokens are credentials, so only admins may enumerate them
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, err := a.isAdmin(ctx, callerInfo.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, &authclient.ErrNotAuthorized{
+			Subject: callerInfo.Subject,
+			AdminOp: "ListTokens",
+		}
+	}
+
+	// Canonicalize the subject filter, if one is set
+	subject := req.Subject
+	if subject != "" {
+		subject, err = a.canonicalizeSubject(ctx, subject)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tokensRO := a.tokens.ReadOnly(ctx)
+	response := &authclient.ListTokensResponse{}
+	var tokenInfo authclient.TokenInfo
+	if err := tokensRO.List(&tokenInfo, col.DefaultOptions, func(hash string) error {
+		// skip magicUser's token (pachd's internal PPS credential)
+		if tokenInfo.Subject == magicUser {
+			return nil
+		}
+		if subject != "" && tokenInfo.Subject != subject {
+			return nil
+		}
+		ttl, err := tokensRO.TTL(hash)
+		if err != nil {
+			return fmt.Errorf("error looking up TTL for token: %v", err)
+		}
+		response.Records = append(response.Records, &authclient.TokenRecord{
+			HashedToken: hash,
+			Subject:     tokenInfo.Subject,
+			Source:      tokenInfo.Source,
+			TTL:         ttl,
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	// Sort the records so that the output is deterministic
+	sort.Slice(response.Records, func(i, j int) bool {
+		return response.Records[i].HashedToken < response.Records[j].HashedToken
+	})
+	return response, nil
+}
+
+// DescribeToken implements the protobuf auth.DescribeToken RPC
+func (a *apiServer) DescribeToken(ctx context.Context, req *authclient.DescribeTokenRequest) (resp *authclient.DescribeTokenResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() != full {
+		return nil, authclient.ErrNotActivated
+	}
+	if req.Token == "" {
+		return nil, fmt.Errorf("must set DescribeTokenRequest.Token")
+	}
+
+	// Users can describe their own tokens, and admins can describe any user's
+	// token
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, err := a.isAdmin(ctx, callerInfo.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensRO := a.tokens.ReadOnly(ctx)
+	var tokenInfo authclient.TokenInfo
+	if err := tokensRO.Get(hashToken(req.Token), &tokenInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, authclient.ErrBadToken
+		}
+		return nil, err
+	}
+	if !isAdmin && tokenInfo.Subject != callerInfo.Subject {
+		return nil, &authclient.ErrNotAuthorized{
+			Subject: callerInfo.Subject,
+			AdminOp: "DescribeToken on another user's token",
+		}
+	}
+	ttl, err := tokensRO.TTL(hashToken(req.Token))
+	if err != nil {
+		return nil, fmt.Errorf("error looking up TTL for token: %v", err)
+	}
+	return &authclient.DescribeTokenResponse{
+		Record: &authclient.TokenRecord{
+			HashedToken: hashToken(req.Token),
+			Subject:     tokenInfo.Subject,
+			Source:      tokenInfo.Source,
+			TTL:         ttl,
+		},
+	}, nil
+}
+
+// RevokeTokensForUser implements the protobuf auth.RevokeTokensForUser RPC
+func (a *apiServer) RevokeTokensForUser(ctx context.Context, req *authclient.RevokeTokensForUserRequest) (resp *authclient.RevokeTokensForUserResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() != full {
+		return nil, authclient.ErrNotActivated
+	}
+	if req.Username == "" {
+		return nil, fmt.Errorf("must set RevokeTokensForUserRequest.Username")
+	}
+
+	// Only admins can revoke a user's tokens wholesale
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, err := a.isAdmin(ctx, callerInfo.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, &authclient.ErrNotAuthorized{
+			Subject: callerInfo.Subject,
+			AdminOp: "RevokeTokensForUser",
+		}
+	}
+
+	subject, err := a.canonicalizeSubject(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	if subject == magicUser {
+		return nil, fmt.Errorf("RevokeTokensForUserRequest.Username is invalid")
+	}
+
+	// Collect the hashes of 'subject's tokens outside the STM (collections
+	// don't support listing inside one), then delete them transactionally,
+	// re-checking each token's subject in case it was concurrently reissued
+	var hashes []string
+	var tokenInfo authclient.TokenInfo
+	if err := a.tokens.ReadOnly(ctx).List(&tokenInfo, col.DefaultOptions, func(hash string) error {
+		if tokenInfo.Subject == subject {
+			hashes = append(hashes, hash)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	var revoked int64
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		revoked = 0
+		tokens := a.tokens.ReadWrite(stm)
+		for _, hash := range hashes {
+			var tokenInfo authclient.TokenInfo
+			if err := tokens.Get(hash, &tokenInfo); err != nil {
+				if col.IsErrNotFound(err) {
+					continue // already revoked or expired
+				}
+				return err
+			}
+			if tokenInfo.Subject != subject {
+				continue
+			}
+			if err := tokens.Delete(hash); err != nil {
+				return err
+			}
+			revoked++
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &authclient.RevokeTokensForUserResponse{Number: revoked}, nil
+}
+
+// setGroupsForUserInternal is a helper function used by SetGroupsForUser, and
+// also by handleSAMLResponse (which updates group membership information based
+// on signed SAML assertions). This does no auth checks, so the caller must do
+// all relevant authorization.
+func (a *apiServer) setGroupsForUserInternal(ctx context.Context, subject string, groups []string) error {
+	_, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		// Get groups to remove/add user from/to
+		var current authclient.Groups
+		if err := a.members.ReadWrite(stm).Get(subject, &current); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		newGroups := addToSet(nil, groups...)
+
+		// Remove user from previous groups
+		for group := range current.Groups {
+			if !newGroups[group] {
+				if err := a.removeUserFromGroup(stm, subject, group); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Add user to new groups
+		for group := range newGroups {
+			if !current.Groups[group] {
+				if err := a.addUserToGroup(stm, subject, group); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+	return err
+}
+
+// addUserToGroup adds 'username' to 'group'. The members and groups
+// collections are reverse indexes of each other, so every membership change
+// must update both; addUserToGroup and removeUserFromGroup are the only
+// places that do so, and callers should never write either collection's
+// membership data directly (Fsck detects and repairs any asymmetry between
+// the two indexes that slips through anyway). Both updates happen in the
+// caller's STM, so they commit or abort together.
+func (a *apiServer) addUserToGroup(stm col.STM, username, group string) error {
+	var groupsProto authclient.Groups
+	if err := a.members.ReadWrite(stm).Upsert(username, &groupsProto, func() error {
+		groupsProto.Groups = addToSet(groupsProto.Groups, group)
+		return nil
+	}); err != nil {
+		return err
+	}
+	var membersProto authclient.Users
+	return a.groups.ReadWrite(stm).Upsert(group, &membersProto, func() error {
+		membersProto.Usernames = addToSet(membersProto.Usernames, username)
+		return nil
+	})
+}
+
+// removeUserFromGroup removes 'username' from 'group', updating both the
+// members and groups collections in the caller's STM (see addUserToGroup)
+func (a *apiServer) removeUserFromGroup(stm col.STM, username, group string) error {
+	var groupsProto authclient.Groups
+	if err := a.members.ReadWrite(stm).Upsert(username, &groupsProto, func() error {
+		groupsProto.Groups = removeFromSet(groupsProto.Groups, group)
+		return nil
+	}); err != nil {
+		return err
+	}
+	var membersProto authclient.Users
+	return a.groups.ReadWrite(stm).Upsert(group, &membersProto, func() error {
+		membersProto.Usernames = removeFromSet(membersProto.Usernames, username)
+		return nil
+	})
+}
+
+// migrateGroupNames is a one-time, idempotent migration that rewrites any
+// group names written before group names were canonicalized (see
+// canonicalizeGroupName) under their canonical names. It runs in a goroutine
+// at startup; because both the old and the new code only ever look groups up
+// by exact name, re-running it (e.g. after a pachd restart) is a no-op once
+// all names are canonical.
+func (a *apiServer) migrateGroupNames() {
+	ctx, cancel := context.WithTimeout(a.shutdownCtx, 2*time.Minute)
+	defer cancel()
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = 60 * time.Second
+	b.MaxInterval = 5 * time.Second
+	if err := backoff.RetryUntilCancel(ctx, func() error {
+		// Collect the names of all known groups (both collections, as groups
+		// created with CreateGroup may have metadata but no members yet)
+		names := make(map[string]bool)
+		var membersProto authclient.Users
+		if err := a.groups.ReadOnly(ctx).List(&membersProto, col.DefaultOptions, func(group string) error {
+			names[group] = true
+			return nil
+		}); err != nil {
+			return err
+		}
+		var info authclient.GroupInfo
+		if err := a.groupInfo.ReadOnly(ctx).List(&info, col.DefaultOptions, func(group string) error {
+			names[group] = true
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for group := range names {
+			canonical, err := a.canonicalizeGroupName(group)
+			if err != nil {
+				// can't guess what this group should be called--leave it alone
+				logrus.Warnf("cannot canonicalize existing group \"%s\": %v", group, err)
+				continue
+			}
+			if canonical == group {
+				continue
+			}
+			if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+				// Move the group's members to its canonical name (merging with
+				// any members already there, in case e.g. "Eng" and "eng" both
+				// exist)
+				groups := a.groups.ReadWrite(stm)
+				var oldMembers authclient.Users
+				if err := groups.Get(group, &oldMembers); err != nil && !col.IsErrNotFound(err) {
+					return err
+				}
+				for username := range oldMembers.Usernames {
+					if err := a.removeUserFromGroup(stm, username, group); err != nil {
+						return err
+					}
+					if err := a.addUserToGroup(stm, username, canonical); err != nil {
+						return err
+					}
+				}
+				if err := groups.Delete(group); err != nil && !col.IsErrNotFound(err) {
+					return err
+				}
+
+				// Move the group's metadata, if any (keeping any metadata
+				// already stored under the canonical name)
+				groupInfo := a.groupInfo.ReadWrite(stm)
+				var oldInfo authclient.GroupInfo
+				if err := groupInfo.Get(group, &oldInfo); err != nil {
+					if col.IsErrNotFound(err) {
+						return nil
+					}
+					return err
+				}
+				var newInfo authclient.GroupInfo
+				if err := groupInfo.Get(canonical, &newInfo); err != nil {
+					if !col.IsErrNotFound(err) {
+						return err
+					}
+					oldInfo.Name = canonical
+					if err := groupInfo.Put(canonical, &oldInfo); err != nil {
+						return err
+					}
+				}
+				return groupInfo.Delete(group)
+			}); err != nil {
+				return err
+			}
+			logrus.Infof("migrated group \"%s\" to canonical name \"%s\"", group, canonical)
+		}
+		return nil
+	}, b, nil); err != nil && a.shutdownCtx.Err() == nil {
+		logrus.Errorf("could not migrate group names to canonical form (will retry on next restart): %v", err)
+	}
+}
+
+// isGroupOwner reports whether 'subject' may manage the group described by
+// 'info' without being a cluster admin (i.e. they created the group or are on
+// its owner list)
+func isGroupOwner(info *authclient.GroupInfo, subject string) bool {
+	if info.Owner == subject {
+		return true
+	}
+	for _, owner := range info.Owners {
+		if owner == subject {
+			return true
 		}
+	}
+	return false
+}
 
-		ttl, err := tokens.TTL(hashToken(req.Token))
-		if err != nil {
-			return fmt.Errorf("Error looking up TTL for token: %v", err)
+// CreateGroup implements the protobuf auth.CreateGroup RPC
+func (a *apiServer) CreateGroup(ctx context.Context, req *authclient.CreateGroupRequest) (resp *authclient.CreateGroupResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() != full {
+		return nil, authclient.ErrNotActivated
+	}
+	if req.Group == "" {
+		return nil, fmt.Errorf("invalid request: must set group")
+	}
+	group, err := a.canonicalizeGroupName(req.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, err := a.isAdmin(ctx, callerInfo.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, &authclient.ErrNotAuthorized{
+			Subject: callerInfo.Subject,
+			AdminOp: "CreateGroup",
 		}
-		// TODO(msteffen): ttl may be -1 if the token has no TTL. We deliberately do
-		// not check this case so that admins can put TTLs on tokens that don't have
-		// them (otherwise any attempt to do so would get ErrTooShortTTL), but that
-		// decision may be revised
-		if req.TTL < ttl {
-			return authclient.ErrTooShortTTL{
-				RequestTTL:  req.TTL,
-				ExistingTTL: ttl,
-			}
+	}
+
+	created, err := types.TimestampProto(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		return a.groupInfo.ReadWrite(stm).Create(group, &authclient.GroupInfo{
+			Name:        group,
+			Description: req.Description,
+			Owner:       callerInfo.Subject,
+			Created:     created,
+		})
+	}); err != nil {
+		if col.IsErrExists(err) {
+			return nil, fmt.Errorf("group \"%s\" already exists", group)
 		}
-		return tokens.PutTTL(hashToken(req.Token), &tokenInfo, req.TTL)
+		return nil, err
+	}
+	return &authclient.CreateGroupResponse{}, nil
+}
+
+// ListGroups implements the protobuf auth.ListGroups RPC
+func (a *apiServer) ListGroups(ctx context.Context, req *authclient.ListGroupsRequest) (resp *authclient.ListGroupsResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() != full {
+		return nil, authclient.ErrNotActivated
+	}
+
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, err := a.isAdmin(ctx, callerInfo.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, &authclient.ErrNotAuthorized{
+			Subject: callerInfo.Subject,
+			AdminOp: "ListGroups",
+		}
+	}
+
+	// Collect matching group names from both the group-info collection and the
+	// groups collection (the latter catches groups that predate CreateGroup and
+	// thus have no metadata)
+	names := make(map[string]bool)
+	var info authclient.GroupInfo
+	if err := a.groupInfo.ReadOnly(ctx).List(&info, col.DefaultOptions, func(group string) error {
+		if strings.HasPrefix(group, req.Prefix) {
+			names[group] = true
+		}
+		return nil
 	}); err != nil {
 		return nil, err
 	}
-	return &authclient.ExtendAuthTokenResponse{}, nil
+	var membersProto authclient.Users
+	groupsRO := a.groups.ReadOnly(ctx)
+	if err := groupsRO.List(&membersProto, col.DefaultOptions, func(group string) error {
+		if strings.HasPrefix(group, req.Prefix) {
+			names[group] = true
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Sort group names (for deterministic pagination) and select the requested
+	// page
+	sorted := make([]string, 0, len(names))
+	for group := range names {
+		if req.PageToken != "" && group <= req.PageToken {
+			continue
+		}
+		sorted = append(sorted, group)
+	}
+	sort.Strings(sorted)
+	response := &authclient.ListGroupsResponse{}
+	if req.PageSize > 0 && int64(len(sorted)) > req.PageSize {
+		response.NextPageToken = sorted[req.PageSize-1]
+		sorted = sorted[:req.PageSize]
+	}
+
+	// Build the response (reading each group's metadata and member count)
+	groupInfoRO := a.groupInfo.ReadOnly(ctx)
+	for _, group := range sorted {
+		info := &authclient.GroupInfo{Name: group}
+		if err := groupInfoRO.Get(group, info); err != nil && !col.IsErrNotFound(err) {
+			return nil, err
+		}
+		var membersProto authclient.Users
+		if err := groupsRO.Get(group, &membersProto); err != nil && !col.IsErrNotFound(err) {
+			return nil, err
+		}
+		info.MemberCount = int64(len(membersProto.Usernames))
+		response.Groups = append(response.Groups, info)
+	}
+	return response, nil
 }
 
-// RevokeAuthToken implements the protobuf auth.RevokeAuthToken RPC
-func (a *apiServer) RevokeAuthToken(ctx context.Context, req *authclient.RevokeAuthTokenRequest) (resp *authclient.RevokeAuthTokenResponse, retErr error) {
+// UpdateGroup implements the protobuf auth.UpdateGroup RPC
+func (a *apiServer) UpdateGroup(ctx context.Context, req *authclient.UpdateGroupRequest) (resp *authclient.UpdateGroupResponse, retErr error) {
 	a.LogReq(req)
 	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
 	if a.activationState() != full {
 		return nil, authclient.ErrNotActivated
 	}
+	if req.Group == "" {
+		return nil, fmt.Errorf("invalid request: must set group")
+	}
+	group, err := a.canonicalizeGroupName(req.Group)
+	if err != nil {
+		return nil, err
+	}
 
-	// Get the caller. Users can revoke their own tokens, and admins can revoke
-	// any user's token
 	callerInfo, err := a.getAuthenticatedUser(ctx)
 	if err != nil {
 		return nil, err
@@ -1790,80 +3605,104 @@ func (a *apiServer) RevokeAuthToken(ctx context.Context, req *authclient.RevokeA
 		return nil, err
 	}
 
+	// Canonicalize any owners being added/removed before entering the STM
+	addOwners, err := a.canonicalizeSubjects(ctx, req.AddOwners)
+	if err != nil {
+		return nil, err
+	}
+	removeOwners, err := a.canonicalizeSubjects(ctx, req.RemoveOwners)
+	if err != nil {
+		return nil, err
+	}
+
 	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
-		tokens := a.tokens.ReadWrite(stm)
-		var tokenInfo authclient.TokenInfo
-		if err := tokens.Get(hashToken(req.Token), &tokenInfo); err != nil {
+		groupInfo := a.groupInfo.ReadWrite(stm)
+		var info authclient.GroupInfo
+		if err := groupInfo.Get(group, &info); err != nil {
 			if col.IsErrNotFound(err) {
-				return nil
+				return fmt.Errorf("group \"%s\" does not exist", group)
 			}
 			return err
 		}
-		if !isAdmin && tokenInfo.Subject != callerInfo.Subject {
+		// Group owners may update their own groups without being cluster admins
+		if !isAdmin && !isGroupOwner(&info, callerInfo.Subject) {
 			return &authclient.ErrNotAuthorized{
 				Subject: callerInfo.Subject,
-				AdminOp: "RevokeAuthToken on another user's token",
+				AdminOp: "UpdateGroup",
 			}
 		}
-		return tokens.Delete(hashToken(req.Token))
+		if req.Description != "" {
+			info.Description = req.Description
+		}
+		ownerSet := addToSet(nil, info.Owners...)
+		ownerSet = addToSet(ownerSet, addOwners...)
+		ownerSet = removeFromSet(ownerSet, removeOwners...)
+		info.Owners = setToList(ownerSet)
+		sort.Strings(info.Owners)
+		return groupInfo.Put(group, &info)
 	}); err != nil {
 		return nil, err
 	}
-	return &authclient.RevokeAuthTokenResponse{}, nil
+	return &authclient.UpdateGroupResponse{}, nil
 }
 
-// setGroupsForUserInternal is a helper function used by SetGroupsForUser, and
-// also by handleSAMLResponse (which updates group membership information based
-// on signed SAML assertions). This does no auth checks, so the caller must do
-// all relevant authorization.
-func (a *apiServer) setGroupsForUserInternal(ctx context.Context, subject string, groups []string) error {
-	_, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
-		members := a.members.ReadWrite(stm)
+// DeleteGroup implements the protobuf auth.DeleteGroup RPC
+func (a *apiServer) DeleteGroup(ctx context.Context, req *authclient.DeleteGroupRequest) (resp *authclient.DeleteGroupResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() != full {
+		return nil, authclient.ErrNotActivated
+	}
+	if req.Group == "" {
+		return nil, fmt.Errorf("invalid request: must set group")
+	}
+	group, err := a.canonicalizeGroupName(req.Group)
+	if err != nil {
+		return nil, err
+	}
 
-		// Get groups to remove/add user from/to
-		var removeGroups authclient.Groups
-		addGroups := addToSet(nil, groups...)
-		if err := members.Get(subject, &removeGroups); err == nil {
-			for _, group := range groups {
-				if removeGroups.Groups[group] {
-					removeGroups.Groups = removeFromSet(removeGroups.Groups, group)
-					addGroups = removeFromSet(addGroups, group)
-				}
-			}
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, err := a.isAdmin(ctx, callerInfo.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, &authclient.ErrNotAuthorized{
+			Subject: callerInfo.Subject,
+			AdminOp: "DeleteGroup",
 		}
+	}
 
-		// Set groups for user
-		if err := members.Put(subject, &authclient.Groups{
-			Groups: addToSet(nil, groups...),
-		}); err != nil {
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		if err := a.groupInfo.ReadWrite(stm).Delete(group); err != nil {
+			if col.IsErrNotFound(err) {
+				return fmt.Errorf("group \"%s\" does not exist", group)
+			}
 			return err
 		}
 
-		// Remove user from previous groups
+		// Remove the group from all of its members' group lists
 		groups := a.groups.ReadWrite(stm)
 		var membersProto authclient.Users
-		for group := range removeGroups.Groups {
-			if err := groups.Upsert(group, &membersProto, func() error {
-				membersProto.Usernames = removeFromSet(membersProto.Usernames, subject)
-				return nil
-			}); err != nil {
-				return err
-			}
+		if err := groups.Get(group, &membersProto); err != nil && !col.IsErrNotFound(err) {
+			return err
 		}
-
-		// Add user to new groups
-		for group := range addGroups {
-			if err := groups.Upsert(group, &membersProto, func() error {
-				membersProto.Usernames = addToSet(membersProto.Usernames, subject)
-				return nil
-			}); err != nil {
+		for username := range membersProto.Usernames {
+			if err := a.removeUserFromGroup(stm, username, group); err != nil {
 				return err
 			}
 		}
-
+		if err := groups.Delete(group); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
 		return nil
-	})
-	return err
+	}); err != nil {
+		return nil, err
+	}
+	return &authclient.DeleteGroupResponse{}, nil
 }
 
 // SetGroupsForUser implements the protobuf auth.SetGroupsForUser RPC
@@ -1894,83 +3733,210 @@ func (a *apiServer) SetGroupsForUser(ctx context.Context, req *authclient.SetGro
 	if err != nil {
 		return nil, err
 	}
-	// TODO(msteffen): canonicalize group names
-	if err := a.setGroupsForUserInternal(ctx, subject, req.Groups); err != nil {
+	groups, err := a.canonicalizeGroupNames(req.Groups)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.setGroupsForUserInternal(ctx, subject, groups); err != nil {
 		return nil, err
 	}
 	return &authclient.SetGroupsForUserResponse{}, nil
 }
 
-// ModifyMembers implements the protobuf auth.ModifyMembers RPC
-func (a *apiServer) ModifyMembers(ctx context.Context, req *authclient.ModifyMembersRequest) (resp *authclient.ModifyMembersResponse, retErr error) {
+// ModifyMembers implements the protobuf auth.ModifyMembers RPC
+func (a *apiServer) ModifyMembers(ctx context.Context, req *authclient.ModifyMembersRequest) (resp *authclient.ModifyMembersResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() != full {
+		return nil, authclient.ErrNotActivated
+	}
+
+	group, err := a.canonicalizeGroupName(req.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, err := a.isAdmin(ctx, callerInfo.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cluster admins can modify any group's membership; owners of the group
+	// (see GroupInfo.owners) can modify their own group's membership
+	if !isAdmin {
+		var info authclient.GroupInfo
+		if err := a.groupInfo.ReadOnly(ctx).Get(group, &info); err != nil && !col.IsErrNotFound(err) {
+			return nil, err
+		}
+		if !isGroupOwner(&info, callerInfo.Subject) {
+			return nil, &authclient.ErrNotAuthorized{
+				Subject: callerInfo.Subject,
+				AdminOp: "ModifyMembers",
+			}
+		}
+	}
+
+	add, err := a.canonicalizeSubjects(ctx, req.Add)
+	if err != nil {
+		return nil, err
+	}
+	// TODO(bryce) Skip canonicalization if the users can be found.
+	remove, err := a.canonicalizeSubjects(ctx, req.Remove)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		// The group must have been created explicitly (with CreateGroup)--groups
+		// no longer spring into existence on first mention
+		var info authclient.GroupInfo
+		if err := a.groupInfo.ReadWrite(stm).Get(group, &info); err != nil {
+			if col.IsErrNotFound(err) {
+				return fmt.Errorf("group \"%s\" does not exist (create it with CreateGroup)", group)
+			}
+			return err
+		}
+
+		for _, username := range add {
+			if err := a.addUserToGroup(stm, username, group); err != nil {
+				return err
+			}
+		}
+		for _, username := range remove {
+			if err := a.removeUserFromGroup(stm, username, group); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &authclient.ModifyMembersResponse{}, nil
+}
+
+// setGroupMembershipChunkSize is the number of groups applied per transaction
+// by SetGroupMembership. Applying the whole request in one STM would exceed
+// etcd's transaction-size limits for large imports; applying one group per
+// STM would be slow
+const setGroupMembershipChunkSize = 64
+
+// SetGroupMembership implements the protobuf auth.SetGroupMembership RPC
+func (a *apiServer) SetGroupMembership(req *authclient.SetGroupMembershipRequest, stream authclient.API_SetGroupMembershipServer) (retErr error) {
 	a.LogReq(req)
-	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	defer func(start time.Time) { a.LogResp(req, nil, retErr, time.Since(start)) }(time.Now())
 	if a.activationState() != full {
-		return nil, authclient.ErrNotActivated
+		return authclient.ErrNotActivated
 	}
+	ctx := stream.Context()
 
 	callerInfo, err := a.getAuthenticatedUser(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	isAdmin, err := a.isAdmin(ctx, callerInfo.Subject)
 	if err != nil {
-		return nil, err
+		return err
 	}
-
 	if !isAdmin {
-		return nil, &authclient.ErrNotAuthorized{
+		return &authclient.ErrNotAuthorized{
 			Subject: callerInfo.Subject,
-			AdminOp: "ModifyMembers",
+			AdminOp: "SetGroupMembership",
 		}
 	}
 
-	add, err := a.canonicalizeSubjects(ctx, req.Add)
-	if err != nil {
-		return nil, err
+	// Canonicalize all group names and member subjects up front, so that the
+	// whole request is validated before any of it is applied
+	memberships := make([]*authclient.GroupMembership, len(req.Memberships))
+	for i, m := range req.Memberships {
+		group, err := a.canonicalizeGroupName(m.Group)
+		if err != nil {
+			return err
+		}
+		usernames, err := a.canonicalizeSubjects(ctx, m.Usernames)
+		if err != nil {
+			return err
+		}
+		memberships[i] = &authclient.GroupMembership{
+			Group:     group,
+			Usernames: usernames,
+		}
 	}
-	// TODO(bryce) Skip canonicalization if the users can be found.
-	remove, err := a.canonicalizeSubjects(ctx, req.Remove)
+
+	created, err := types.TimestampProto(time.Now())
 	if err != nil {
-		return nil, err
+		return err
 	}
-
-	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
-		members := a.members.ReadWrite(stm)
-		var groupsProto authclient.Groups
-		for _, username := range add {
-			if err := members.Upsert(username, &groupsProto, func() error {
-				groupsProto.Groups = addToSet(groupsProto.Groups, req.Group)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		for _, username := range remove {
-			if err := members.Upsert(username, &groupsProto, func() error {
-				groupsProto.Groups = removeFromSet(groupsProto.Groups, req.Group)
-				return nil
-			}); err != nil {
-				return err
-			}
+	total := int64(len(memberships))
+	var applied int64
+	for len(memberships) > 0 {
+		chunk := memberships
+		if len(chunk) > setGroupMembershipChunkSize {
+			chunk = chunk[:setGroupMembershipChunkSize]
 		}
+		memberships = memberships[len(chunk):]
+		if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+			groups := a.groups.ReadWrite(stm)
+			groupInfo := a.groupInfo.ReadWrite(stm)
+			for _, m := range chunk {
+				// Unlike ModifyMembers, groups don't need to be created in
+				// advance--SetGroupMembership exists to bootstrap a cluster's
+				// groups, so it creates any that are missing
+				var info authclient.GroupInfo
+				if err := groupInfo.Get(m.Group, &info); err != nil {
+					if !col.IsErrNotFound(err) {
+						return err
+					}
+					if err := groupInfo.Put(m.Group, &authclient.GroupInfo{
+						Name:    m.Group,
+						Owner:   callerInfo.Subject,
+						Created: created,
+					}); err != nil {
+						return err
+					}
+				}
 
-		groups := a.groups.ReadWrite(stm)
-		var membersProto authclient.Users
-		if err := groups.Upsert(req.Group, &membersProto, func() error {
-			membersProto.Usernames = addToSet(membersProto.Usernames, add...)
-			membersProto.Usernames = removeFromSet(membersProto.Usernames, remove...)
+				// Replace the group's member list wholesale
+				newMembers := addToSet(nil, m.Usernames...)
+				var oldMembers authclient.Users
+				if err := groups.Get(m.Group, &oldMembers); err != nil && !col.IsErrNotFound(err) {
+					return err
+				}
+				for username := range oldMembers.Usernames {
+					if newMembers[username] {
+						continue
+					}
+					if err := a.removeUserFromGroup(stm, username, m.Group); err != nil {
+						return err
+					}
+				}
+				for username := range newMembers {
+					if oldMembers.Usernames[username] {
+						continue
+					}
+					if err := a.addUserToGroup(stm, username, m.Group); err != nil {
+						return err
+					}
+				}
+			}
 			return nil
 		}); err != nil {
 			return err
 		}
-
-		return nil
-	}); err != nil {
-		return nil, err
+		applied += int64(len(chunk))
+		if err := stream.Send(&authclient.SetGroupMembershipProgress{
+			GroupsApplied: applied,
+			GroupsTotal:   total,
+		}); err != nil {
+			return err
+		}
 	}
-
-	return &authclient.ModifyMembersResponse{}, nil
+	return nil
 }
 
 func addToSet(set map[string]bool, elems ...string) map[string]bool {
@@ -1995,17 +3961,36 @@ func removeFromSet(set map[string]bool, elems ...string) map[string]bool {
 }
 
 // getGroups is a helper function used primarily by the GRPC API GetGroups, but
-// also by Authorize() and isAdmin().
+// also by Authorize() and isAdmin(). Group membership is resolved
+// transitively: a group can itself be a member of another group (added via
+// ModifyMembers, using the group's name as the member--see GroupPrefix), in
+// which case members of the inner group also belong to the containing group.
+// The visited set guards against membership cycles.
 func (a *apiServer) getGroups(ctx context.Context, subject string) ([]string, error) {
 	members := a.members.ReadOnly(ctx)
-	var groupsProto authclient.Groups
-	if err := members.Get(subject, &groupsProto); err != nil {
-		if col.IsErrNotFound(err) {
-			return []string{}, nil
+	groups := []string{}
+	visited := map[string]bool{subject: true}
+	queue := []string{subject}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		var groupsProto authclient.Groups
+		if err := members.Get(cur, &groupsProto); err != nil {
+			if col.IsErrNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		for group := range groupsProto.Groups {
+			if visited[group] {
+				continue
+			}
+			visited[group] = true
+			groups = append(groups, group)
+			queue = append(queue, group)
 		}
-		return nil, err
 	}
-	return setToList(groupsProto.Groups), nil
+	return groups, nil
 }
 
 // GetGroups implements the protobuf auth.GetGroups RPC
@@ -2105,6 +4090,330 @@ func (a *apiServer) GetUsers(ctx context.Context, req *authclient.GetUsersReques
 	return &authclient.GetUsersResponse{Usernames: users}, nil
 }
 
+// Fsck implements the protobuf auth.Fsck RPC. It scans the members and groups
+// collections (which are reverse indexes of each other--see addUserToGroup)
+// for asymmetric membership links and, if req.Fix is set, repairs them by
+// adding the missing half of each link
+func (a *apiServer) Fsck(ctx context.Context, req *authclient.FsckRequest) (resp *authclient.FsckResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	// activation and admin checks for this RPC run in the auth interceptor
+	// (see authPolicies)
+
+	// Read both indexes into memory (collections can't be listed inside an
+	// STM, so the scan happens outside of any transaction)
+	memberGroups := make(map[string]map[string]bool) // user -> groups
+	var groupsProto authclient.Groups
+	if err := a.members.ReadOnly(ctx).List(&groupsProto, col.DefaultOptions, func(user string) error {
+		memberGroups[user] = addToSet(nil, setToList(groupsProto.Groups)...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	groupMembers := make(map[string]map[string]bool) // group -> users
+	var membersProto authclient.Users
+	if err := a.groups.ReadOnly(ctx).List(&membersProto, col.DefaultOptions, func(group string) error {
+		groupMembers[group] = addToSet(nil, setToList(membersProto.Usernames)...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Find asymmetric links. 'broken' collects the (user, group) pair behind
+	// each inconsistency so that Fix can repair them below
+	type link struct{ user, group string }
+	var broken []link
+	response := &authclient.FsckResponse{}
+	for user, groups := range memberGroups {
+		for group := range groups {
+			if !groupMembers[group][user] {
+				response.Inconsistencies = append(response.Inconsistencies,
+					fmt.Sprintf("user \"%s\" has group \"%s\" in its group list, but isn't in that group's member list", user, group))
+				broken = append(broken, link{user, group})
+			}
+		}
+	}
+	for group, users := range groupMembers {
+		for user := range users {
+			if !memberGroups[user][group] {
+				response.Inconsistencies = append(response.Inconsistencies,
+					fmt.Sprintf("group \"%s\" has user \"%s\" in its member list, but isn't in that user's group list", group, user))
+				broken = append(broken, link{user, group})
+			}
+		}
+	}
+	sort.Strings(response.Inconsistencies)
+
+	if req.Fix {
+		// addUserToGroup re-reads both indexes inside the STM and is
+		// idempotent, so repairing a link that another writer has already
+		// fixed (or completed) is harmless
+		if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+			for _, l := range broken {
+				if err := a.addUserToGroup(stm, l.user, l.group); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// authStateFormatVersion identifies the layout of the record stream produced
+// by ExtractAuthState. RestoreAuthState rejects streams with a version it
+// doesn't recognize, so that a restore from an incompatible future release
+// fails up front instead of corrupting the cluster's auth state
+const authStateFormatVersion = 1
+
+// ExtractAuthState implements the protobuf auth.ExtractAuthState RPC. It
+// streams the cluster's complete auth state (config, admins, ACLs, groups,
+// and robot tokens) as a sequence of records that RestoreAuthState can
+// reload, so auth state survives cluster migrations
+func (a *apiServer) ExtractAuthState(req *authclient.ExtractAuthStateRequest, stream authclient.API_ExtractAuthStateServer) (retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, nil, retErr, time.Since(start)) }(time.Now())
+	// activation and admin checks for this RPC run in the auth interceptor
+	// (see authPolicies)
+	ctx := stream.Context()
+
+	records, err := a.collectAuthStateRecords(ctx)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if err := stream.Send(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectAuthStateRecords reads the cluster's complete auth state into a
+// sequence of AuthStateRecords (beginning with a header record), for
+// ExtractAuthState and for Deactivate's archive option
+func (a *apiServer) collectAuthStateRecords(ctx context.Context) ([]*authclient.AuthStateRecord, error) {
+	records := []*authclient.AuthStateRecord{{
+		Header: &authclient.AuthStateHeader{Version: authStateFormatVersion},
+	}}
+
+	// auth config
+	var config authclient.AuthConfig
+	if err := a.authConfig.ReadOnly(ctx).Get(configKey, &config); err != nil {
+		if !col.IsErrNotFound(err) {
+			return nil, err
+		}
+	} else {
+		records = append(records, &authclient.AuthStateRecord{Config: &config})
+	}
+
+	// cluster admins
+	var boolProto types.BoolValue
+	if err := a.admins.ReadOnly(ctx).List(&boolProto, col.DefaultOptions, func(admin string) error {
+		records = append(records, &authclient.AuthStateRecord{Admin: admin})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// ACLs
+	var acl authclient.ACL
+	if err := a.acls.ReadOnly(ctx).List(&acl, col.DefaultOptions, func(repo string) error {
+		records = append(records, &authclient.AuthStateRecord{
+			ACL: &authclient.RepoACL{
+				Repo: repo,
+				ACL:  proto.Clone(&acl).(*authclient.ACL),
+			},
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// group metadata and memberships (the members collection isn't extracted;
+	// it's a reverse index of the groups collection, and RestoreAuthState
+	// rebuilds it from these records)
+	var info authclient.GroupInfo
+	if err := a.groupInfo.ReadOnly(ctx).List(&info, col.DefaultOptions, func(group string) error {
+		records = append(records, &authclient.AuthStateRecord{
+			GroupInfo: proto.Clone(&info).(*authclient.GroupInfo),
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	var membersProto authclient.Users
+	if err := a.groups.ReadOnly(ctx).List(&membersProto, col.DefaultOptions, func(group string) error {
+		records = append(records, &authclient.AuthStateRecord{
+			Group: &authclient.GroupMembership{
+				Group:     group,
+				Usernames: setToList(membersProto.Usernames),
+			},
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// robot tokens (other tokens are deliberately omitted--human users can
+	// log in again, but robot tokens can't be re-acquired)
+	var tokenInfo authclient.TokenInfo
+	if err := a.tokens.ReadOnly(ctx).List(&tokenInfo, col.DefaultOptions, func(hash string) error {
+		if !strings.HasPrefix(tokenInfo.Subject, authclient.RobotPrefix) {
+			return nil
+		}
+		records = append(records, &authclient.AuthStateRecord{
+			RobotToken: &authclient.HashedTokenInfo{
+				HashedToken: hash,
+				TokenInfo:   proto.Clone(&tokenInfo).(*authclient.TokenInfo),
+			},
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// archiveAuthState writes the cluster's complete auth state (as a JSON array
+// of AuthStateRecords) under the object-storage prefix 'archiveURL', so that
+// the state can be inspected--or fed back through RestoreAuthState--after
+// e.g. a Deactivate call that requested an archive
+func (a *apiServer) archiveAuthState(ctx context.Context, archiveURL string) error {
+	records, err := a.collectAuthStateRecords(ctx)
+	if err != nil {
+		return err
+	}
+	storeURL, err := obj.ParseURL(archiveURL)
+	if err != nil {
+		return fmt.Errorf("could not parse archive URL (%q): %v", archiveURL, err)
+	}
+	client, err := obj.NewClientFromURLAndSecret(storeURL)
+	if err != nil {
+		return err
+	}
+	objName := path.Join(storeURL.Object, fmt.Sprintf("auth-state-%s-%s.json",
+		time.Now().UTC().Format("2006-01-02T15-04-05"), uuid.NewWithoutDashes()))
+	w, err := client.Writer(ctx, objName)
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(w).Encode(records); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// RestoreAuthState implements the protobuf auth.RestoreAuthState RPC. It
+// reads a stream of records produced by ExtractAuthState and loads them into
+// this cluster. The restore is additive: records are upserted over whatever
+// auth state already exists
+func (a *apiServer) RestoreAuthState(stream authclient.API_RestoreAuthStateServer) (retErr error) {
+	a.pachLogger.Log(nil, nil, nil, 0)
+	defer func(start time.Time) { a.LogResp(nil, nil, retErr, time.Since(start)) }(time.Now())
+	// activation and admin checks for this RPC run in the auth interceptor
+	// (see authPolicies)
+	ctx := stream.Context()
+
+	// The first record must be a header with a version this pachd understands
+	record, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("could not read auth state header: %v", err)
+	}
+	if record.Header == nil {
+		return fmt.Errorf("invalid auth state stream: first record must be a header")
+	}
+	if record.Header.Version != authStateFormatVersion {
+		return fmt.Errorf("unsupported auth state version %d (this cluster supports version %d)",
+			record.Header.Version, authStateFormatVersion)
+	}
+
+	for {
+		record, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := a.restoreAuthStateRecord(ctx, record); err != nil {
+			return err
+		}
+	}
+	return stream.SendAndClose(&authclient.RestoreAuthStateResponse{})
+}
+
+// restoreAuthStateRecord applies one record from a RestoreAuthState stream
+func (a *apiServer) restoreAuthStateRecord(ctx context.Context, record *authclient.AuthStateRecord) error {
+	switch {
+	case record.Config != nil:
+		// the extracted config was validated by the source cluster, so it's
+		// treated as an internal config (its SAML metadata is already
+		// embedded and isn't re-fetched)
+		canonicalCfg, err := validateConfig(ctx, a.env, record.Config, internal)
+		if err != nil {
+			return fmt.Errorf("could not restore auth config: %v", err)
+		}
+		configProto, err := canonicalCfg.ToProto()
+		if err != nil {
+			return err
+		}
+		_, err = col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+			var liveConfig authclient.AuthConfig
+			return a.authConfig.ReadWrite(stm).Upsert(configKey, &liveConfig, func() error {
+				liveConfigVersion := liveConfig.LiveConfigVersion
+				liveConfig.Reset()
+				liveConfig = *configProto
+				liveConfig.LiveConfigVersion = liveConfigVersion + 1
+				return nil
+			})
+		})
+		return err
+	case record.Admin != "":
+		_, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+			return a.admins.ReadWrite(stm).Put(record.Admin, epsilon)
+		})
+		return err
+	case record.ACL != nil:
+		_, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+			return a.acls.ReadWrite(stm).Put(record.ACL.Repo, record.ACL.ACL)
+		})
+		return err
+	case record.GroupInfo != nil:
+		_, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+			info := proto.Clone(record.GroupInfo).(*authclient.GroupInfo)
+			info.MemberCount = 0 // computed in ListGroups, never stored
+			return a.groupInfo.ReadWrite(stm).Put(info.Name, info)
+		})
+		return err
+	case record.Group != nil:
+		_, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+			for _, username := range record.Group.Usernames {
+				if err := a.addUserToGroup(stm, username, record.Group.Group); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		return err
+	case record.RobotToken != nil:
+		if !strings.HasPrefix(record.RobotToken.TokenInfo.GetSubject(), authclient.RobotPrefix) {
+			return fmt.Errorf("invalid robot token record (subject %q is not a robot user)",
+				record.RobotToken.TokenInfo.GetSubject())
+		}
+		_, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+			return a.tokens.ReadWrite(stm).Put(record.RobotToken.HashedToken, record.RobotToken.TokenInfo)
+		})
+		return err
+	case record.Header != nil:
+		return fmt.Errorf("invalid auth state stream: unexpected header record")
+	}
+	return fmt.Errorf("invalid auth state stream: empty record")
+}
+
 func setToList(set map[string]bool) []string {
 	if set == nil {
 		return []string{}
@@ -2140,14 +4449,17 @@ func getAuthToken(ctx context.Context) (string, error) {
 }
 
 func (a *apiServer) getAuthenticatedUser(ctx context.Context) (*authclient.TokenInfo, error) {
-	// TODO(msteffen) cache these lookups, especially since users always authorize
-	// themselves at the beginning of a request. Don't want to look up the same
-	// token -> username entry twice.
+	// The auth interceptor may already have resolved the caller for this
+	// request (see auth_interceptor.go), in which case the token doesn't need
+	// to be looked up a second time
+	if info, ok := callerInfoFromContext(ctx); ok {
+		return info, nil
+	}
 	token, err := getAuthToken(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if token == a.ppsToken {
+	if ppsToken := a.getPPSToken(); ppsToken != "" && token == ppsToken {
 		// TODO(msteffen): This is a hack. The idea is that there is a logical user
 		// entry mapping ppsToken to magicUser. Soon, magicUser will go away and
 		// this check should happen in authorize
@@ -2174,16 +4486,24 @@ func (a *apiServer) canonicalizeSubjects(ctx context.Context, subjects []string)
 		return []string{}, nil
 	}
 
+	// Canonicalize each distinct subject once--large ACL updates often repeat
+	// subjects, and each uncached GitHub subject costs an API call
+	canonical := make(map[string]string, len(subjects))
+	for _, subject := range subjects {
+		canonical[subject] = ""
+	}
 	eg := &errgroup.Group{}
-	canonicalizedSubjects := make([]string, len(subjects))
-	for i, subject := range subjects {
-		i, subject := i, subject
+	var mu sync.Mutex
+	for subject := range canonical {
+		subject := subject
 		eg.Go(func() error {
-			subject, err := a.canonicalizeSubject(ctx, subject)
+			result, err := a.canonicalizeSubject(ctx, subject)
 			if err != nil {
 				return err
 			}
-			canonicalizedSubjects[i] = subject
+			mu.Lock()
+			canonical[subject] = result
+			mu.Unlock()
 			return nil
 		})
 	}
@@ -2191,6 +4511,10 @@ func (a *apiServer) canonicalizeSubjects(ctx context.Context, subjects []string)
 		return nil, err
 	}
 
+	canonicalizedSubjects := make([]string, len(subjects))
+	for i, subject := range subjects {
+		canonicalizedSubjects[i] = canonical[subject]
+	}
 	return canonicalizedSubjects, nil
 }
 
@@ -2224,11 +4548,11 @@ func (a *apiServer) canonicalizeSubject(ctx context.Context, subject string) (st
 	switch prefix {
 	case authclient.GitHubPrefix:
 		var err error
-		subject, err = canonicalizeGitHubUsername(ctx, subject[len(authclient.GitHubPrefix):])
+		subject, err = a.canonicalizeGitHubUsername(ctx, subject[len(authclient.GitHubPrefix):])
 		if err != nil {
 			return "", err
 		}
-	case authclient.PipelinePrefix, authclient.RobotPrefix:
+	case authclient.PipelinePrefix, authclient.RobotPrefix, authclient.GroupPrefix:
 		break
 	default:
 		return "", fmt.Errorf("subject has unrecognized prefix: %s", subject[:colonIdx+1])
@@ -2236,24 +4560,60 @@ func (a *apiServer) canonicalizeSubject(ctx context.Context, subject string) (st
 	return subject, nil
 }
 
-// canonicalizeGitHubUsername corrects 'user' for case errors by looking
-// up the corresponding user's GitHub profile and extracting their login ID
-// from that. 'user' should not have any subject prefixes (as they are required
-// to be a GitHub user).
-func canonicalizeGitHubUsername(ctx context.Context, user string) (string, error) {
-	if strings.Index(user, ":") >= 0 {
-		return "", fmt.Errorf("invalid username has multiple prefixes: %s%s", authclient.GitHubPrefix, user)
+// canonicalizeGroupNames applies canonicalizeGroupName to each element of
+// 'groups' (no lookups are involved, so unlike canonicalizeSubjects this
+// doesn't parallelize)
+func (a *apiServer) canonicalizeGroupNames(groups []string) ([]string, error) {
+	if groups == nil {
+		return []string{}, nil
 	}
-	if os.Getenv(DisableAuthenticationEnvVar) == "true" {
-		// authentication is off -- user might not even be real
-		return authclient.GitHubPrefix + user, nil
+	canonicalizedGroups := make([]string, len(groups))
+	for i, group := range groups {
+		var err error
+		canonicalizedGroups[i], err = a.canonicalizeGroupName(group)
+		if err != nil {
+			return nil, err
+		}
 	}
-	gclient := github.NewClient(http.DefaultClient)
-	u, _, err := gclient.Users.Get(ctx, strings.ToLower(user))
-	if err != nil {
-		return "", fmt.Errorf("error canonicalizing \"%s\": %v", user, err)
+	return canonicalizedGroups, nil
+}
+
+// canonicalizeGroupName validates and canonicalizes the group name 'group'.
+// Groups asserted by a configured ID provider (whose names carry the
+// "group/<idp>:" prefix--see canonicalizeSubject) are passed through
+// unchanged, as the IdP owns their spelling. All other group names must carry
+// the "group:" prefix (it's added if 'group' has no prefix at all) and are
+// lowercased, so that e.g. "engineering" and "Engineering" name the same
+// group. Names with any other subject prefix ("github:", "robot:",
+// "pipeline:", etc.) are rejected, as those subjects can't be groups.
+func (a *apiServer) canonicalizeGroupName(group string) (string, error) {
+	if group == "" {
+		return "", fmt.Errorf("invalid group name (empty)")
+	}
+
+	// check prefix against config cache--IdP-asserted group names pass through
+	if config := a.getCacheConfig(); config != nil {
+		for _, idp := range config.IDPs {
+			if strings.HasPrefix(group, path.Join("group", idp.Name)+":") {
+				return group, nil
+			}
+		}
+	}
+
+	name := group
+	if colonIdx := strings.Index(group, ":"); colonIdx >= 0 {
+		if group[:colonIdx+1] != authclient.GroupPrefix {
+			return "", fmt.Errorf("invalid group name \"%s\": must have the prefix \"%s\" or no prefix", group, authclient.GroupPrefix)
+		}
+		name = group[colonIdx+1:]
 	}
-	return authclient.GitHubPrefix + u.GetLogin(), nil
+	if name == "" {
+		return "", fmt.Errorf("invalid group name \"%s\": empty after prefix", group)
+	}
+	if strings.Contains(name, ":") {
+		return "", fmt.Errorf("invalid group name has multiple prefixes: %s", group)
+	}
+	return authclient.GroupPrefix + strings.ToLower(name), nil
 }
 
 // GetConfiguration implements the protobuf auth.GetConfiguration RPC. Other
@@ -2334,13 +4694,27 @@ func (a *apiServer) SetConfiguration(ctx context.Context, req *authclient.SetCon
 		// Explicitly store default auth config so that config version keeps
 		req.Configuration = proto.Clone(&defaultAuthConfig).(*authclient.AuthConfig)
 	}
-	canonicalConfig, err := validateConfig(req.Configuration, external)
+	canonicalConfig, err := validateConfig(ctx, a.env, req.Configuration, external)
 	// Validate new config
 	if err != nil {
 		return nil, err
 	}
 
+	// On a dry run, return the canonicalized config (and any warnings) without
+	// writing anything
+	if req.DryRun {
+		canonicalConfigProto, err := canonicalConfig.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		return &authclient.SetConfigurationResponse{
+			CanonicalizedConfiguration: canonicalConfigProto,
+			Warnings:                   configWarnings(canonicalConfig, req.Configuration),
+		}, nil
+	}
+
 	// upsert new config
+	var oldVersion int64
 	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
 		var liveConfig authclient.AuthConfig
 		return a.authConfig.ReadWrite(stm).Upsert(configKey, &liveConfig, func() error {
@@ -2356,11 +4730,13 @@ func (a *apiServer) SetConfiguration(ctx context.Context, req *authclient.SetCon
 			}
 			liveConfig.Reset()
 			liveConfig = *liveConfigP
+			oldVersion = liveConfigVersion
 			liveConfig.LiveConfigVersion = liveConfigVersion + 1
 			return nil
 		})
 	}); err != nil {
 		return nil, err
 	}
+	a.notifyChange("SetConfiguration", callerInfo.Subject, oldVersion, oldVersion+1)
 	return &authclient.SetConfigurationResponse{}, nil
 }
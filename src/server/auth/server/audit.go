@@ -0,0 +1,313 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/syslog"
+	"net/http"
+	"net/url"
+	"path"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	logrus "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+)
+
+const (
+	// auditBufferSize is the number of audit records that can be waiting for
+	// delivery before emit starts dropping them (delivery must never block
+	// the API handlers that generate records)
+	auditBufferSize = 1024
+
+	// auditBatchSize bounds the number of records delivered to each sink at
+	// once (one webhook POST, one object, etc.)
+	auditBatchSize = 100
+
+	// auditDeliveryTimeout bounds how long the delivery loop retries a batch
+	// against a failing sink before dropping the batch
+	auditDeliveryTimeout = time.Minute
+)
+
+// auditedMethods is the set of auth RPCs for which audit records are
+// generated (the mutating subset of the API--reads aren't audited, as e.g.
+// Authorize is called on every PFS operation and would swamp any sink)
+var auditedMethods = map[string]bool{
+	"Activate":            true,
+	"Deactivate":          true,
+	"ModifyAdmins":        true,
+	"SetScope":            true,
+	"SetACL":              true,
+	"RestoreACL":          true,
+	"GetAuthToken":        true,
+	"ExtendAuthToken":     true,
+	"RevokeAuthToken":     true,
+	"RevokeTokensForUser": true,
+	"CreateGroup":         true,
+	"UpdateGroup":         true,
+	"DeleteGroup":         true,
+	"SetGroupsForUser":    true,
+	"ModifyMembers":       true,
+	"SetGroupMembership":  true,
+	"SetConfiguration":    true,
+	"Fsck":                true,
+}
+
+// auditRecord is one entry in the audit stream: a single call to a mutating
+// auth RPC. Records are serialized as JSON before being handed to sinks
+type auditRecord struct {
+	Time    time.Time `json:"time"`
+	Method  string    `json:"method"`
+	Request string    `json:"request"`
+	Error   string    `json:"error,omitempty"`
+}
+
+// auditSink delivers batches of audit records to one external destination.
+// send may be called repeatedly with the same batch (it's retried with
+// backoff), so implementations should tolerate duplicate delivery
+type auditSink interface {
+	name() string
+	send(ctx context.Context, records []*auditRecord) error
+}
+
+// webhookAuditSink POSTs each batch of records to an HTTP(S) endpoint as a
+// JSON array
+type webhookAuditSink struct {
+	sinkName string
+	endpoint *url.URL
+}
+
+func (s *webhookAuditSink) name() string { return s.sinkName }
+
+func (s *webhookAuditSink) send(ctx context.Context, records []*auditRecord) error {
+	requestBody, err := json.Marshal(records)
+	if err != nil {
+		return fmt.Errorf("could not marshal audit records: %v", err)
+	}
+	req, err := http.NewRequest("POST", s.endpoint.String(), bytes.NewReader(requestBody))
+	if err != nil {
+		return fmt.Errorf("could not create audit webhook request: %v", err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("audit webhook returned %d %s", resp.StatusCode, resp.Status)
+	}
+	return nil
+}
+
+// objAuditSink writes each batch of records as one JSON object under a prefix
+// in object storage (e.g. "s3://bucket/pach-audit"). Object names embed the
+// batch's timestamp so that records can be replayed in order
+type objAuditSink struct {
+	sinkName string
+	url      string
+
+	clientOnce sync.Once
+	client     obj.Client
+	clientErr  error
+	prefix     string
+}
+
+func (s *objAuditSink) name() string { return s.sinkName }
+
+func (s *objAuditSink) send(ctx context.Context, records []*auditRecord) error {
+	// The object client is created lazily (rather than when the config is
+	// set) so that a misconfigured sink surfaces as delivery errors rather
+	// than blocking SetConfiguration
+	s.clientOnce.Do(func() {
+		storeURL, err := obj.ParseURL(s.url)
+		if err != nil {
+			s.clientErr = err
+			return
+		}
+		s.prefix = storeURL.Object
+		s.client, s.clientErr = obj.NewClientFromURLAndSecret(storeURL)
+	})
+	if s.clientErr != nil {
+		return fmt.Errorf("could not create audit object storage client: %v", s.clientErr)
+	}
+	objName := path.Join(s.prefix, fmt.Sprintf("%s-%s.json",
+		records[0].Time.UTC().Format("2006-01-02T15-04-05"), uuid.NewWithoutDashes()))
+	w, err := s.client.Writer(ctx, objName)
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(w).Encode(records); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// syslogAuditSink writes each record as one syslog message, either to the
+// local syslog daemon or to a remote collector
+type syslogAuditSink struct {
+	sinkName string
+	network  string
+	address  string
+	tag      string
+
+	mu     sync.Mutex // guards 'writer'
+	writer *syslog.Writer
+}
+
+func (s *syslogAuditSink) name() string { return s.sinkName }
+
+func (s *syslogAuditSink) send(_ context.Context, records []*auditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.writer == nil {
+		tag := s.tag
+		if tag == "" {
+			tag = "pachd-auth"
+		}
+		var err error
+		if s.writer, err = syslog.Dial(s.network, s.address, syslog.LOG_INFO|syslog.LOG_AUTH, tag); err != nil {
+			return err
+		}
+	}
+	for _, record := range records {
+		recordJSON, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("could not marshal audit record: %v", err)
+		}
+		if err := s.writer.Info(string(recordJSON)); err != nil {
+			// drop the connection so the next attempt re-dials
+			s.writer.Close()
+			s.writer = nil
+			return err
+		}
+	}
+	return nil
+}
+
+// auditor buffers audit records generated by the API handlers and streams
+// them to the sinks in the cluster's auth config. Delivery is asynchronous
+// (emit never blocks) and each batch is retried with backoff, but records are
+// dropped--with an error in pachd's logs--if the buffer fills up or a sink
+// stays unreachable past auditDeliveryTimeout
+type auditor struct {
+	records chan *auditRecord
+
+	mu    sync.Mutex // guards 'sinks'
+	sinks []auditSink
+}
+
+func newAuditor() *auditor {
+	a := &auditor{
+		records: make(chan *auditRecord, auditBufferSize),
+	}
+	go a.run()
+	return a
+}
+
+// setConfig replaces the auditor's sinks with those in 'config' (nil clears
+// them). It's called by setCacheConfig whenever the cluster's auth config
+// changes
+func (a *auditor) setConfig(config *canonicalConfig) {
+	var sinks []auditSink
+	if config != nil {
+		for _, sink := range config.AuditSinks {
+			switch {
+			case sink.Webhook != nil:
+				sinks = append(sinks, &webhookAuditSink{
+					sinkName: sink.Name,
+					endpoint: sink.Webhook,
+				})
+			case sink.ObjectStorage != "":
+				sinks = append(sinks, &objAuditSink{
+					sinkName: sink.Name,
+					url:      sink.ObjectStorage,
+				})
+			case sink.Syslog != nil:
+				sinks = append(sinks, &syslogAuditSink{
+					sinkName: sink.Name,
+					network:  sink.Syslog.Network,
+					address:  sink.Syslog.Address,
+					tag:      sink.Syslog.Tag,
+				})
+			}
+		}
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.sinks = sinks
+}
+
+func (a *auditor) getSinks() []auditSink {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.sinks
+}
+
+// emit queues one audit record for delivery. It never blocks: if the buffer
+// is full (e.g. because all sinks are down), the record is dropped
+func (a *auditor) emit(record *auditRecord) {
+	select {
+	case a.records <- record:
+	default:
+		logrus.Errorf("audit buffer full; dropping audit record for %s", record.Method)
+	}
+}
+
+// run is the auditor's delivery loop. It batches queued records and sends
+// each batch to every configured sink, retrying failed sends with backoff
+func (a *auditor) run() {
+	for record := range a.records {
+		batch := []*auditRecord{record}
+		for len(batch) < auditBatchSize {
+			select {
+			case record := <-a.records:
+				batch = append(batch, record)
+				continue
+			default:
+			}
+			break
+		}
+		for _, sink := range a.getSinks() {
+			ctx, cancel := context.WithTimeout(context.Background(), auditDeliveryTimeout)
+			b := backoff.NewExponentialBackOff()
+			b.MaxElapsedTime = auditDeliveryTimeout
+			if err := backoff.RetryNotify(func() error {
+				return sink.send(ctx, batch)
+			}, b, func(err error, d time.Duration) error {
+				logrus.Warnf("error sending audit records to sink %q: %v; retrying in %v", sink.name(), err, d)
+				return nil
+			}); err != nil {
+				logrus.Errorf("dropping %d audit record(s): could not deliver to sink %q: %v", len(batch), sink.name(), err)
+			}
+			cancel()
+		}
+	}
+}
+
+// auditMethodName returns the name of the GRPC method whose deferred LogResp
+// call (indirectly) invoked it, mirroring the stack inspection in the log
+// package: the deferred closure's name has the form
+// "<pkg>.(*apiServer).<Method>.func1"
+func auditMethodName() string {
+	pc := make([]uintptr, 1)
+	// skip runtime.Callers, auditMethodName, and LogResp to land on the
+	// deferred closure inside the RPC handler
+	runtime.Callers(3, pc)
+	split := strings.Split(runtime.FuncForPC(pc[0]).Name(), ".")
+	name := split[len(split)-1]
+	if strings.HasPrefix(name, "func") && len(split) > 1 {
+		name = split[len(split)-2]
+	}
+	return name
+}
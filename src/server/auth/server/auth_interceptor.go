@@ -0,0 +1,171 @@
+package server
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	authclient "github.com/pachyderm/pachyderm/src/client/auth"
+)
+
+// authPolicy declares the checks that the auth interceptors perform before an
+// RPC's handler runs: the required activation state, whether the caller's
+// token is resolved (and attached to the request context, where
+// getAuthenticatedUser finds it without a second etcd lookup), and whether
+// the caller must be a cluster admin
+type authPolicy struct {
+	// minState is the least-activated state in which the RPC may run; below
+	// it, the RPC fails with ErrNotActivated
+	minState activationState
+
+	// errPartial indicates that a partially-activated cluster yields
+	// ErrPartiallyActivated rather than ErrNotActivated (some RPCs
+	// deliberately blur the distinction, so this isn't implied by minState)
+	errPartial bool
+
+	// resolveCaller indicates that the caller's token is resolved to a
+	// TokenInfo and attached to the request context
+	resolveCaller bool
+
+	// adminOp, if set, requires the caller to be a cluster admin; its value
+	// is the operation reported in the resulting ErrNotAuthorized
+	adminOp string
+}
+
+// authPolicies maps gRPC methods to the checks the auth interceptors perform
+// for them. Methods are added here as their handlers' ad-hoc preambles are
+// migrated; methods that aren't listed keep implementing their own checks
+// (e.g. because they distinguish admins from repo owners, or must run while
+// auth is inactive)
+var authPolicies = map[string]authPolicy{
+	"/auth.API/WhoAmI":           {minState: partial, resolveCaller: true},
+	"/auth.API/ModifyAdmins":     {minState: full, errPartial: true, resolveCaller: true, adminOp: "ModifyAdmins"},
+	"/auth.API/Fsck":             {minState: full, resolveCaller: true, adminOp: "Fsck"},
+	"/auth.API/ExtractAuthState": {minState: full, resolveCaller: true, adminOp: "ExtractAuthState"},
+	"/auth.API/RestoreAuthState": {minState: full, resolveCaller: true, adminOp: "RestoreAuthState"},
+}
+
+// callerInfoKey is the context key under which the auth interceptors cache
+// the caller's resolved TokenInfo
+type callerInfoKey struct{}
+
+// withCallerInfo caches 'info' in 'ctx' (see callerInfoFromContext)
+func withCallerInfo(ctx context.Context, info *authclient.TokenInfo) context.Context {
+	return context.WithValue(ctx, callerInfoKey{}, info)
+}
+
+// callerInfoFromContext returns the caller's TokenInfo if an auth interceptor
+// already resolved it for this request
+func callerInfoFromContext(ctx context.Context) (*authclient.TokenInfo, bool) {
+	info, ok := ctx.Value(callerInfoKey{}).(*authclient.TokenInfo)
+	return info, ok
+}
+
+// AuthInterceptor is a gRPC interceptor that performs the activation,
+// authentication, and admin checks declared in authPolicies before the
+// corresponding handlers run. Like NetworkPolicyEnforcer, it's created before
+// pachd's gRPC server and bound to the auth API server once that exists;
+// until then it passes all calls through
+type AuthInterceptor struct {
+	mu     sync.Mutex // guards 'server'
+	server APIServer
+}
+
+// NewAuthInterceptor returns an unbound AuthInterceptor (see Bind)
+func NewAuthInterceptor() *AuthInterceptor {
+	return &AuthInterceptor{}
+}
+
+// Bind attaches 'server' (which must have been returned by NewAuthServer) to
+// 'i', so that i's interceptors enforce authPolicies against it
+func (i *AuthInterceptor) Bind(server APIServer) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.server = server
+}
+
+// UnaryInterceptor applies the method's authPolicy to unary RPCs
+func (i *AuthInterceptor) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := i.check(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor applies the method's authPolicy to streaming RPCs
+func (i *AuthInterceptor) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := i.check(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	if ctx != ss.Context() {
+		ss = &authServerStream{ServerStream: ss, ctx: ctx}
+	}
+	return handler(srv, ss)
+}
+
+// authServerStream overrides a ServerStream's context with one carrying the
+// caller's resolved TokenInfo
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// check applies fullMethod's authPolicy, if it has one, and returns the
+// request context (with the caller's TokenInfo attached, if it was resolved)
+func (i *AuthInterceptor) check(ctx context.Context, fullMethod string) (context.Context, error) {
+	i.mu.Lock()
+	server := i.server
+	i.mu.Unlock()
+	if server == nil {
+		return ctx, nil // not yet bound to an auth server
+	}
+	policy, ok := authPolicies[fullMethod]
+	if !ok {
+		return ctx, nil // the handler implements its own checks
+	}
+	// resolve the auth domain that will handle this request (on clusters with
+	// auth tenants, each tenant's domain has its own activation state and
+	// admins--see tenant.go)
+	a, err := apiServerForRequest(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		return ctx, nil
+	}
+	state := a.activationState()
+	if state < policy.minState {
+		if state == partial && policy.errPartial {
+			return nil, authclient.ErrPartiallyActivated
+		}
+		return nil, authclient.ErrNotActivated
+	}
+	if !policy.resolveCaller {
+		return ctx, nil
+	}
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = withCallerInfo(ctx, callerInfo)
+	if policy.adminOp != "" {
+		isAdmin, err := a.isAdmin(ctx, callerInfo.Subject)
+		if err != nil {
+			return nil, err
+		}
+		if !isAdmin {
+			return nil, &authclient.ErrNotAuthorized{
+				Subject: callerInfo.Subject,
+				AdminOp: policy.adminOp,
+			}
+		}
+	}
+	return ctx, nil
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/context"
+
+	authclient "github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
+)
+
+// externalAuthorizeTimeout bounds how long Authorize will wait for a decision
+// from an external authorizer endpoint. The endpoint is expected to be a
+// sidecar (e.g. an OPA agent in the same pod), so decisions should be fast
+const externalAuthorizeTimeout = 5 * time.Second
+
+// externalAuthorizeInput is the request context that pachd sends to an
+// external authorizer endpoint. The document is wrapped in {"input": ...} to
+// match OPA's data API, but any endpoint that speaks this protocol can be
+// used
+type externalAuthorizeInput struct {
+	Subject string   `json:"subject"`
+	Groups  []string `json:"groups"`
+	Repo    string   `json:"repo"`
+	Scope   string   `json:"scope"`
+}
+
+// externalAuthorize sends the context of an Authorize request (subject,
+// groups, repo, and requested scope) to the external authorizer endpoint at
+// 'endpoint' and returns its decision. Errors (endpoint unreachable, invalid
+// response, etc.) fail closed--the caller treats them as a denied request
+func externalAuthorize(ctx context.Context, endpoint *url.URL, subject string, groups []string, repo string, scope authclient.Scope) (bool, error) {
+	if groups == nil {
+		groups = []string{}
+	}
+	requestBody, err := json.Marshal(map[string]interface{}{
+		"input": &externalAuthorizeInput{
+			Subject: subject,
+			Groups:  groups,
+			Repo:    repo,
+			Scope:   scope.String(),
+		},
+	})
+	if err != nil {
+		return false, fmt.Errorf("could not marshal external authorizer request: %v", err)
+	}
+
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/auth.server/ExternalAuthorize",
+		"endpoint", endpoint.String(), "repo", repo, "scope", scope.String())
+	defer tracing.FinishAnySpan(span)
+	ctx, cancel := context.WithTimeout(ctx, externalAuthorizeTimeout)
+	defer cancel()
+	req, err := http.NewRequest("POST", endpoint.String(), bytes.NewReader(requestBody))
+	if err != nil {
+		return false, fmt.Errorf("could not create external authorizer request: %v", err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("could not query external authorizer: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("external authorizer returned %d %s", resp.StatusCode, resp.Status)
+	}
+	responseBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return false, fmt.Errorf("could not read external authorizer response: %v", err)
+	}
+	var decision struct {
+		Result bool `json:"result"`
+	}
+	if err := json.Unmarshal(responseBody, &decision); err != nil {
+		return false, fmt.Errorf("could not parse external authorizer response: %v", err)
+	}
+	return decision.Result, nil
+}
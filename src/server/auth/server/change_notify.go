@@ -0,0 +1,141 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	logrus "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+)
+
+// changeDeliveryTimeout bounds how long a change notification is retried
+// against an unreachable webhook before being dropped
+const changeDeliveryTimeout = time.Minute
+
+// changeRecord is the JSON document POSTed to each configured config webhook
+// when SetConfiguration or ModifyAdmins succeeds
+type changeRecord struct {
+	Time  time.Time `json:"time"`
+	Event string    `json:"event"` // "SetConfiguration" or "ModifyAdmins"
+	Admin string    `json:"admin"` // the admin who made the change
+
+	// the cluster's auth config version before and after the change (equal
+	// for ModifyAdmins, which doesn't modify the config)
+	OldConfigVersion int64 `json:"old_config_version"`
+	NewConfigVersion int64 `json:"new_config_version"`
+}
+
+// changeNotifier asynchronously POSTs changeRecords to the config webhooks in
+// the cluster's auth config. Like the auditor, it never blocks the RPC
+// handlers that generate records, and drops records (with an error in pachd's
+// logs) if a webhook stays unreachable past changeDeliveryTimeout
+type changeNotifier struct {
+	records chan *changeRecord
+
+	mu       sync.Mutex // guards 'webhooks'
+	webhooks []*url.URL
+}
+
+func newChangeNotifier() *changeNotifier {
+	n := &changeNotifier{
+		records: make(chan *changeRecord, 64),
+	}
+	go n.run()
+	return n
+}
+
+// setConfig replaces the notifier's webhooks with those in 'config' (nil
+// clears them). It's called by setCacheConfig whenever the cluster's auth
+// config changes
+func (n *changeNotifier) setConfig(config *canonicalConfig) {
+	var webhooks []*url.URL
+	if config != nil {
+		webhooks = config.ConfigWebhooks
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.webhooks = webhooks
+}
+
+func (n *changeNotifier) getWebhooks() []*url.URL {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.webhooks
+}
+
+// emit queues one change notification for delivery. It never blocks: if the
+// buffer is full, the notification is dropped
+func (n *changeNotifier) emit(record *changeRecord) {
+	select {
+	case n.records <- record:
+	default:
+		logrus.Errorf("change notification buffer full; dropping %s notification", record.Event)
+	}
+}
+
+// run is the notifier's delivery loop: each record is POSTed to every
+// configured webhook, retrying failed sends with backoff
+func (n *changeNotifier) run() {
+	for record := range n.records {
+		requestBody, err := json.Marshal(record)
+		if err != nil {
+			logrus.Errorf("could not marshal change notification: %v", err)
+			continue
+		}
+		for _, webhook := range n.getWebhooks() {
+			ctx, cancel := context.WithTimeout(context.Background(), changeDeliveryTimeout)
+			b := backoff.NewExponentialBackOff()
+			b.MaxElapsedTime = changeDeliveryTimeout
+			if err := backoff.RetryNotify(func() error {
+				return postChangeRecord(ctx, webhook, requestBody)
+			}, b, func(err error, d time.Duration) error {
+				logrus.Warnf("error sending change notification to %q: %v; retrying in %v", webhook, err, d)
+				return nil
+			}); err != nil {
+				logrus.Errorf("dropping %s change notification: could not deliver to %q: %v", record.Event, webhook, err)
+			}
+			cancel()
+		}
+	}
+}
+
+func postChangeRecord(ctx context.Context, webhook *url.URL, requestBody []byte) error {
+	req, err := http.NewRequest("POST", webhook.String(), bytes.NewReader(requestBody))
+	if err != nil {
+		return fmt.Errorf("could not create change notification request: %v", err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("config webhook returned %d %s", resp.StatusCode, resp.Status)
+	}
+	return nil
+}
+
+// notifyChange emits one change notification (see changeRecord). 'old' and
+// 'new' are the cluster's auth config version before and after the change
+func (a *apiServer) notifyChange(event, admin string, oldVersion, newVersion int64) {
+	if a.changeNotifier == nil {
+		return
+	}
+	a.changeNotifier.emit(&changeRecord{
+		Time:             time.Now(),
+		Event:            event,
+		Admin:            admin,
+		OldConfigVersion: oldVersion,
+		NewConfigVersion: newVersion,
+	})
+}
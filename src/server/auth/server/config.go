@@ -7,15 +7,22 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/url"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/crewjam/saml"
 	logrus "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 )
 
@@ -31,6 +38,81 @@ const (
 	external
 )
 
+// configRefEnvPrefix and configRefSecretPrefix mark auth config values
+// (metadata URLs, TLS keys, etc.) that are references to be resolved by pachd
+// at load time rather than literal values, so that secrets never sit in etcd
+// in plaintext:
+//
+//	env://VAR_NAME           resolves to the value of pachd's $VAR_NAME
+//	secret://secret/key      resolves to the given key of a Kubernetes secret
+//	                         in pachd's namespace
+const (
+	configRefEnvPrefix    = "env://"
+	configRefSecretPrefix = "secret://"
+)
+
+// isConfigRef indicates whether the auth config value 'value' is an env:// or
+// secret:// reference (see configRefEnvPrefix)
+func isConfigRef(value string) bool {
+	return strings.HasPrefix(value, configRefEnvPrefix) ||
+		strings.HasPrefix(value, configRefSecretPrefix)
+}
+
+// resolveConfigRef resolves the auth config value 'value' if it's an env:// or
+// secret:// reference (see configRefEnvPrefix) and returns it unchanged
+// otherwise
+func resolveConfigRef(env *serviceenv.ServiceEnv, value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, configRefEnvPrefix):
+		name := strings.TrimPrefix(value, configRefEnvPrefix)
+		resolved, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("the auth config references the environment "+
+				"variable %q, which is not set", name)
+		}
+		return resolved, nil
+	case strings.HasPrefix(value, configRefSecretPrefix):
+		ref := strings.TrimPrefix(value, configRefSecretPrefix)
+		parts := strings.SplitN(ref, "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return "", fmt.Errorf("invalid secret reference %q in the auth config "+
+				"(must be of the form \"secret://<secret>/<key>\")", value)
+		}
+		if env == nil {
+			return "", fmt.Errorf("cannot resolve the secret reference %q without "+
+				"a Kubernetes client", value)
+		}
+		secret, err := env.GetKubeClient().CoreV1().Secrets(env.Namespace).Get(
+			parts[0], metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("could not resolve the secret reference %q: %v",
+				value, err)
+		}
+		data, ok := secret.Data[parts[1]]
+		if !ok {
+			return "", fmt.Errorf("could not resolve the secret reference %q: the "+
+				"secret %q has no key %q", value, parts[0], parts[1])
+		}
+		return string(data), nil
+	}
+	return value, nil
+}
+
+// readConfigFile reads the file-valued auth config value 'value': if it's an
+// env:// or secret:// reference, the file's contents are the resolved value
+// of the reference; otherwise 'value' is a path in the pachd container, and
+// readConfigFile reads it
+func readConfigFile(env *serviceenv.ServiceEnv, value string) ([]byte, error) {
+	if isConfigRef(value) {
+		resolved, err := resolveConfigRef(env, value)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(resolved), nil
+	}
+	return ioutil.ReadFile(value)
+}
+
 type canonicalSAMLIDP struct {
 	MetadataURL    *url.URL
 	Metadata       *saml.EntityDescriptor
@@ -41,6 +123,15 @@ type canonicalIDPConfig struct {
 	Name        string
 	Description string
 
+	// SessionDuration, if set, overrides SAMLSvc.SessionDuration for users
+	// who authenticate via this ID provider (optional)
+	SessionDuration time.Duration
+
+	// DefaultTokenTTL, if set, overrides the default token lifetime for users
+	// who authenticate via this ID provider when no session duration applies
+	// (optional)
+	DefaultTokenTTL time.Duration
+
 	SAML *canonicalSAMLIDP
 }
 
@@ -49,6 +140,38 @@ type canonicalSAMLSvcConfig struct {
 	MetadataURL     *url.URL
 	DashURL         *url.URL      // optional (use defaultDashRedirectURL if unset)
 	SessionDuration time.Duration // optional
+
+	// TLSCertFile and TLSKeyFile are paths to a cert/key pair with which the
+	// SAML services are served (optional--if unset, they're served over
+	// plain HTTP)
+	TLSCertFile string
+	TLSKeyFile  string
+}
+
+type canonicalExternalAuthorizerConfig struct {
+	Endpoint *url.URL
+}
+
+type canonicalAuditSinkConfig struct {
+	Name string
+
+	// exactly one of the following is set
+	Webhook       *url.URL
+	ObjectStorage string // validated by obj.ParseURL
+	Syslog        *canonicalSyslogConfig
+}
+
+type canonicalSyslogConfig struct {
+	Network string
+	Address string
+	Tag     string
+}
+
+type canonicalNetworkPolicyConfig struct {
+	// parsed CIDRs from the corresponding AuthConfig fields; an empty list
+	// allows all addresses
+	AuthenticateAllowCIDRs []*net.IPNet
+	AdminAllowCIDRs        []*net.IPNet
 }
 
 // canonicalConfig contains the values specified in an auth.AuthConfig proto
@@ -68,14 +191,94 @@ type canonicalConfig struct {
 
 	// SAMLSvc must be set
 	SAMLSvc *canonicalSAMLSvcConfig
+
+	// GetACLRequiresReader indicates whether GetACL requires the caller to have
+	// at least READER access to the repo whose ACL they're reading (admins are
+	// exempt)
+	GetACLRequiresReader bool
+
+	// ExternalAuthorizer, if set, is an external policy endpoint (e.g. an Open
+	// Policy Agent sidecar) that Authorize consults instead of repo ACLs
+	ExternalAuthorizer *canonicalExternalAuthorizerConfig
+
+	// AuditSinks are external destinations to which the auth server streams
+	// audit records of auth API calls (see audit.go)
+	AuditSinks []canonicalAuditSinkConfig
+
+	// NetworkPolicy, if set, restricts which source addresses may call
+	// sensitive auth RPCs (see network_policy.go)
+	NetworkPolicy *canonicalNetworkPolicyConfig
+
+	// ConfigWebhooks are endpoints notified of successful SetConfiguration and
+	// ModifyAdmins calls (see change_notify.go)
+	ConfigWebhooks []*url.URL
+
+	// OfflineCanonicalization indicates whether remote canonicalization of
+	// GitHub subjects is disabled (usernames are syntax-checked but taken
+	// literally, for air-gapped clusters that can't reach the GitHub API)
+	OfflineCanonicalization bool
 }
 
 func (c *canonicalConfig) ToProto() (*auth.AuthConfig, error) {
+	// options that are independent of any authentication backend are preserved
+	// even if no ID providers are configured
+	var extAuthProto *auth.AuthConfig_ExternalAuthorizerOptions
+	if c.ExternalAuthorizer != nil {
+		extAuthProto = &auth.AuthConfig_ExternalAuthorizerOptions{
+			Endpoint: c.ExternalAuthorizer.Endpoint.String(),
+		}
+	}
+	var auditSinkProtos []*auth.AuthConfig_AuditSink
+	for _, sink := range c.AuditSinks {
+		sinkProto := &auth.AuthConfig_AuditSink{Name: sink.Name}
+		switch {
+		case sink.Webhook != nil:
+			sinkProto.Webhook = &auth.AuthConfig_AuditSink_WebhookOptions{
+				URL: sink.Webhook.String(),
+			}
+		case sink.ObjectStorage != "":
+			sinkProto.ObjectStorage = &auth.AuthConfig_AuditSink_ObjectStorageOptions{
+				URL: sink.ObjectStorage,
+			}
+		case sink.Syslog != nil:
+			sinkProto.Syslog = &auth.AuthConfig_AuditSink_SyslogOptions{
+				Network: sink.Syslog.Network,
+				Address: sink.Syslog.Address,
+				Tag:     sink.Syslog.Tag,
+			}
+		}
+		auditSinkProtos = append(auditSinkProtos, sinkProto)
+	}
+	var configWebhookProtos []*auth.AuthConfig_ConfigWebhookOptions
+	for _, webhook := range c.ConfigWebhooks {
+		configWebhookProtos = append(configWebhookProtos,
+			&auth.AuthConfig_ConfigWebhookOptions{URL: webhook.String()})
+	}
+	var networkPolicyProto *auth.AuthConfig_NetworkPolicyOptions
+	if c.NetworkPolicy != nil {
+		networkPolicyProto = &auth.AuthConfig_NetworkPolicyOptions{}
+		for _, cidr := range c.NetworkPolicy.AuthenticateAllowCIDRs {
+			networkPolicyProto.AuthenticateAllowCIDRs = append(
+				networkPolicyProto.AuthenticateAllowCIDRs, cidr.String())
+		}
+		for _, cidr := range c.NetworkPolicy.AdminAllowCIDRs {
+			networkPolicyProto.AdminAllowCIDRs = append(
+				networkPolicyProto.AdminAllowCIDRs, cidr.String())
+		}
+	}
+
 	// ToProto may be called on an empty canonical config if the user is setting
 	// an empty config (the empty AuthConfig proto will be validated and then
 	// reverted to a proto before being written to etcd)
 	if c.IsEmpty() {
-		return &auth.AuthConfig{}, nil
+		return &auth.AuthConfig{
+			GetACLRequiresReader:    c.GetACLRequiresReader,
+			ExternalAuthorizer:      extAuthProto,
+			AuditSinks:              auditSinkProtos,
+			NetworkPolicy:           networkPolicyProto,
+			ConfigWebhooks:          configWebhookProtos,
+			OfflineCanonicalization: c.OfflineCanonicalization,
+		}, nil
 	}
 
 	var idpProtos []*auth.IDProvider
@@ -95,6 +298,12 @@ func (c *canonicalConfig) ToProto() (*auth.AuthConfig, error) {
 				GroupAttribute: idp.SAML.GroupAttribute,
 			},
 		}
+		if idp.SessionDuration > 0 {
+			samlIDP.SessionDuration = idp.SessionDuration.String()
+		}
+		if idp.DefaultTokenTTL > 0 {
+			samlIDP.DefaultTokenTTL = idp.DefaultTokenTTL.String()
+		}
 		if idp.SAML.MetadataURL != nil {
 			samlIDP.SAML.MetadataURL = idp.SAML.MetadataURL.String()
 		}
@@ -113,11 +322,19 @@ func (c *canonicalConfig) ToProto() (*auth.AuthConfig, error) {
 		if c.SAMLSvc.SessionDuration > 0 {
 			svcCfgProto.SessionDuration = c.SAMLSvc.SessionDuration.String()
 		}
+		svcCfgProto.TLSCertFile = c.SAMLSvc.TLSCertFile
+		svcCfgProto.TLSKeyFile = c.SAMLSvc.TLSKeyFile
 	}
 
 	return &auth.AuthConfig{
-		IDProviders:        idpProtos,
-		SAMLServiceOptions: svcCfgProto,
+		IDProviders:             idpProtos,
+		SAMLServiceOptions:      svcCfgProto,
+		GetACLRequiresReader:    c.GetACLRequiresReader,
+		ExternalAuthorizer:      extAuthProto,
+		AuditSinks:              auditSinkProtos,
+		NetworkPolicy:           networkPolicyProto,
+		ConfigWebhooks:          configWebhookProtos,
+		OfflineCanonicalization: c.OfflineCanonicalization,
 	}, nil
 }
 
@@ -129,12 +346,16 @@ func (c *canonicalConfig) IsEmpty() bool {
 // SAML ID provider's Metadata service, queries it, parses the result, and
 // returns it as a struct the crewjam/saml library can use.  This code is
 // heavily based on the crewjam/saml/samlsp.Middleware constructor
-func fetchRawIDPMetadata(name string, mdURL *url.URL) ([]byte, error) {
+func fetchRawIDPMetadata(ctx context.Context, name string, mdURL *url.URL) ([]byte, error) {
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/auth.saml/FetchIDPMetadata",
+		"idp", name)
+	defer tracing.FinishAnySpan(span)
 	c := http.DefaultClient
 	req, err := http.NewRequest("GET", mdURL.String(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve IdP metadata for %q: %v", name, err)
 	}
+	req = req.WithContext(ctx)
 	req.Header.Set("User-Agent", "Golang; github.com/pachyderm/pachdyerm")
 
 	var rawMetadata []byte
@@ -172,7 +393,7 @@ func fetchRawIDPMetadata(name string, mdURL *url.URL) ([]byte, error) {
 
 // validateIDP is a helper for validateConfig, that validates each ID provider
 // in the config
-func validateIDP(idp *auth.IDProvider, src configSource) (*canonicalIDPConfig, error) {
+func validateIDP(ctx context.Context, env *serviceenv.ServiceEnv, idp *auth.IDProvider, src configSource) (*canonicalIDPConfig, error) {
 	// Validate the ID Provider's name (must exist and must not be reserved)
 	if idp.Name == "" {
 		return nil, errors.New("All ID providers must have a name specified (for " +
@@ -205,6 +426,21 @@ func validateIDP(idp *auth.IDProvider, src configSource) (*canonicalIDPConfig, e
 	newIDP := &canonicalIDPConfig{}
 	newIDP.Name = idp.Name
 	newIDP.Description = idp.Description
+	var err error
+	if idp.SessionDuration != "" {
+		newIDP.SessionDuration, err = time.ParseDuration(idp.SessionDuration)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse session duration for the ID "+
+				"provider %q: %v", idp.Name, err)
+		}
+	}
+	if idp.DefaultTokenTTL != "" {
+		newIDP.DefaultTokenTTL, err = time.ParseDuration(idp.DefaultTokenTTL)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse default token TTL for the ID "+
+				"provider %q: %v", idp.Name, err)
+		}
+	}
 	newIDP.SAML = &canonicalSAMLIDP{
 		GroupAttribute: idp.SAML.GroupAttribute,
 	}
@@ -230,7 +466,6 @@ func validateIDP(idp *auth.IDProvider, src configSource) (*canonicalIDPConfig, e
 	} else {
 		// Parse URL even if this is an internal cfg and IDPMetadata is already
 		// set, so that GetConfig can return it
-		var err error
 		newIDP.SAML.MetadataURL, err = url.Parse(idp.SAML.MetadataURL)
 		if err != nil {
 			return nil, fmt.Errorf("Could not parse SAML IDP metadata URL (%q) to "+
@@ -246,7 +481,21 @@ func validateIDP(idp *auth.IDProvider, src configSource) (*canonicalIDPConfig, e
 				return nil, fmt.Errorf("cannot set both metadata_xml and metadata_url "+
 					"for the SAML ID provider %q", idp.Name)
 			}
-			rawIDPMetadata, err = fetchRawIDPMetadata(idp.Name, newIDP.SAML.MetadataURL)
+			// The metadata URL may be an env:// or secret:// reference; pachd
+			// resolves it here, at load time, and stores the unresolved reference
+			// in etcd
+			fetchURL := newIDP.SAML.MetadataURL
+			if isConfigRef(idp.SAML.MetadataURL) {
+				resolved, err := resolveConfigRef(env, idp.SAML.MetadataURL)
+				if err != nil {
+					return nil, err
+				}
+				if fetchURL, err = url.Parse(resolved); err != nil {
+					return nil, fmt.Errorf("could not parse the resolved SAML IDP "+
+						"metadata URL for %q: %v", idp.Name, err)
+				}
+			}
+			rawIDPMetadata, err = fetchRawIDPMetadata(ctx, idp.Name, fetchURL)
 			if err != nil {
 				return nil, err
 			}
@@ -263,7 +512,7 @@ func validateIDP(idp *auth.IDProvider, src configSource) (*canonicalIDPConfig, e
 	// Parse IDP metadata. This code is heavily based on the
 	// crewjam/saml/samlsp.Middleware constructor
 	newIDP.SAML.Metadata = &saml.EntityDescriptor{}
-	err := xml.Unmarshal(rawIDPMetadata, newIDP.SAML.Metadata)
+	err = xml.Unmarshal(rawIDPMetadata, newIDP.SAML.Metadata)
 	if err != nil {
 		// this comparison is ugly, but it is how the error is generated in
 		// encoding/xml
@@ -292,12 +541,14 @@ func validateIDP(idp *auth.IDProvider, src configSource) (*canonicalIDPConfig, e
 // validateConfig converts an auth.AuthConfig proto from an RPC into a
 // canonicalized config (with all URLs parsed, SAML metadata fetched and
 // persisted, etc.)
-func validateConfig(config *auth.AuthConfig, src configSource) (*canonicalConfig, error) {
+func validateConfig(ctx context.Context, env *serviceenv.ServiceEnv, config *auth.AuthConfig, src configSource) (*canonicalConfig, error) {
 	if config == nil {
 		config = &auth.AuthConfig{}
 	}
 	c := &canonicalConfig{
-		Version: config.LiveConfigVersion,
+		Version:                 config.LiveConfigVersion,
+		GetACLRequiresReader:    config.GetACLRequiresReader,
+		OfflineCanonicalization: config.OfflineCanonicalization,
 	}
 	var err error
 
@@ -313,7 +564,7 @@ func validateConfig(config *auth.AuthConfig, src configSource) (*canonicalConfig
 			}
 			samlIDP = idp.Name
 		}
-		canonicalIDP, err := validateIDP(idp, src)
+		canonicalIDP, err := validateIDP(ctx, env, idp, src)
 		if err != nil {
 			return nil, err
 		}
@@ -329,6 +580,107 @@ func validateConfig(config *auth.AuthConfig, src configSource) (*canonicalConfig
 		return nil, errors.New("must set saml_svc_options if a SAML ID provider has been configured")
 	}
 
+	// Validate external_authorizer options
+	if config.ExternalAuthorizer != nil {
+		extAuthProto := config.ExternalAuthorizer
+		if extAuthProto.Endpoint == "" {
+			return nil, errors.New("invalid external authorizer options: must set endpoint")
+		}
+		c.ExternalAuthorizer = &canonicalExternalAuthorizerConfig{}
+		if c.ExternalAuthorizer.Endpoint, err = url.Parse(extAuthProto.Endpoint); err != nil {
+			return nil, fmt.Errorf("could not parse external authorizer endpoint (%q): %v",
+				extAuthProto.Endpoint, err)
+		} else if c.ExternalAuthorizer.Endpoint.Scheme == "" {
+			return nil, fmt.Errorf("external authorizer endpoint %q is invalid (no scheme)",
+				extAuthProto.Endpoint)
+		}
+	}
+
+	// Validate audit_sinks
+	for i, sinkProto := range config.AuditSinks {
+		sink := canonicalAuditSinkConfig{Name: sinkProto.Name}
+		if sink.Name == "" {
+			return nil, fmt.Errorf("invalid audit sink #%d: must set name", i+1)
+		}
+		numOpts := 0
+		if sinkProto.Webhook != nil {
+			numOpts++
+			if sinkProto.Webhook.URL == "" {
+				return nil, fmt.Errorf("invalid audit sink %q: must set webhook URL", sink.Name)
+			}
+			if sink.Webhook, err = url.Parse(sinkProto.Webhook.URL); err != nil {
+				return nil, fmt.Errorf("could not parse audit sink %q webhook URL (%q): %v",
+					sink.Name, sinkProto.Webhook.URL, err)
+			} else if sink.Webhook.Scheme == "" {
+				return nil, fmt.Errorf("audit sink %q webhook URL %q is invalid (no scheme)",
+					sink.Name, sinkProto.Webhook.URL)
+			}
+		}
+		if sinkProto.ObjectStorage != nil {
+			numOpts++
+			if sinkProto.ObjectStorage.URL == "" {
+				return nil, fmt.Errorf("invalid audit sink %q: must set object storage URL", sink.Name)
+			}
+			if _, err := obj.ParseURL(sinkProto.ObjectStorage.URL); err != nil {
+				return nil, fmt.Errorf("could not parse audit sink %q object storage URL (%q): %v",
+					sink.Name, sinkProto.ObjectStorage.URL, err)
+			}
+			sink.ObjectStorage = sinkProto.ObjectStorage.URL
+		}
+		if sinkProto.Syslog != nil {
+			numOpts++
+			if sinkProto.Syslog.Network != "" && sinkProto.Syslog.Address == "" {
+				return nil, fmt.Errorf("invalid audit sink %q: must set syslog address if network is set", sink.Name)
+			}
+			sink.Syslog = &canonicalSyslogConfig{
+				Network: sinkProto.Syslog.Network,
+				Address: sinkProto.Syslog.Address,
+				Tag:     sinkProto.Syslog.Tag,
+			}
+		}
+		if numOpts != 1 {
+			return nil, fmt.Errorf("invalid audit sink %q: must set exactly one of "+
+				"webhook, object_storage, or syslog", sink.Name)
+		}
+		c.AuditSinks = append(c.AuditSinks, sink)
+	}
+
+	// Validate config_webhooks
+	for i, webhookProto := range config.ConfigWebhooks {
+		if webhookProto.URL == "" {
+			return nil, fmt.Errorf("invalid config webhook #%d: must set URL", i+1)
+		}
+		webhook, err := url.Parse(webhookProto.URL)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse config webhook URL (%q): %v",
+				webhookProto.URL, err)
+		} else if webhook.Scheme == "" {
+			return nil, fmt.Errorf("config webhook URL %q is invalid (no scheme)",
+				webhookProto.URL)
+		}
+		c.ConfigWebhooks = append(c.ConfigWebhooks, webhook)
+	}
+
+	// Validate network_policy
+	if config.NetworkPolicy != nil {
+		c.NetworkPolicy = &canonicalNetworkPolicyConfig{}
+		for _, p := range []struct {
+			cidrs  []string
+			parsed *[]*net.IPNet
+		}{
+			{config.NetworkPolicy.AuthenticateAllowCIDRs, &c.NetworkPolicy.AuthenticateAllowCIDRs},
+			{config.NetworkPolicy.AdminAllowCIDRs, &c.NetworkPolicy.AdminAllowCIDRs},
+		} {
+			for _, cidr := range p.cidrs {
+				_, ipNet, err := net.ParseCIDR(cidr)
+				if err != nil {
+					return nil, fmt.Errorf("could not parse network policy CIDR (%q): %v", cidr, err)
+				}
+				*p.parsed = append(*p.parsed, ipNet)
+			}
+		}
+	}
+
 	// Validate saml_svc_options
 	if config.SAMLServiceOptions != nil {
 		svcCfgProto := config.SAMLServiceOptions
@@ -371,6 +723,15 @@ func validateConfig(config *auth.AuthConfig, src configSource) (*canonicalConfig
 				return nil, fmt.Errorf("could not parse SAML-based session duration: %v", err)
 			}
 		}
+
+		// validate TLS cert/key paths (the files themselves are only read when
+		// serving, so that certs rotated on disk take effect without a restart)
+		if (svcCfgProto.TLSCertFile == "") != (svcCfgProto.TLSKeyFile == "") {
+			return nil, errors.New("invalid SAML service options: must set both " +
+				"tls_cert_file and tls_key_file, or neither")
+		}
+		c.SAMLSvc.TLSCertFile = svcCfgProto.TLSCertFile
+		c.SAMLSvc.TLSKeyFile = svcCfgProto.TLSKeyFile
 	}
 
 	return c, nil
@@ -387,16 +748,30 @@ func (a *apiServer) setCacheConfig(config *auth.AuthConfig) error {
 	if config == nil {
 		a.configCache = nil
 		a.samlSP = nil
+		if a.auditor != nil {
+			a.auditor.setConfig(nil)
+		}
+		if a.changeNotifier != nil {
+			a.changeNotifier.setConfig(nil)
+		}
+		a.restartSAMLServerIfTLSChanged(nil)
+		a.notifyAuthStateChange()
 		return nil
 	}
 
-	newConfig, err := validateConfig(config, internal)
+	newConfig, err := validateConfig(a.shutdownCtx, a.env, config, internal)
 	if err != nil {
 		return err
 	}
 
 	// Set a.configCache and possibly a.samlSP
 	a.configCache = newConfig
+	if a.auditor != nil {
+		a.auditor.setConfig(newConfig)
+	}
+	if a.changeNotifier != nil {
+		a.changeNotifier.setConfig(newConfig)
+	}
 	a.samlSP = nil // overwrite if there's a SAML ID provider
 	for _, idp := range newConfig.IDPs {
 		if idp.SAML != nil {
@@ -417,9 +792,56 @@ func (a *apiServer) setCacheConfig(config *auth.AuthConfig) error {
 			}
 		}
 	}
+	// If the new config enables or disables TLS for the SAML services, close
+	// the running SAML server so that serveSAML re-listens with the new
+	// settings
+	a.restartSAMLServerIfTLSChanged(newConfig)
+	// The config version changed; wake any WatchAuthState streams
+	a.notifyAuthStateChange()
 	return nil
 }
 
+// restartSAMLServerIfTLSChanged is a helper for setCacheConfig: it closes the
+// running SAML HTTP server if 'newConfig' changes whether the SAML services
+// should be served over TLS (serveSAML then re-listens with the new settings)
+func (a *apiServer) restartSAMLServerIfTLSChanged(newConfig *canonicalConfig) {
+	var useTLS bool
+	if newConfig != nil && newConfig.SAMLSvc != nil {
+		useTLS = newConfig.SAMLSvc.TLSCertFile != ""
+	}
+	a.samlServerMu.Lock()
+	defer a.samlServerMu.Unlock()
+	if a.samlServer != nil && a.samlServerTLS != useTLS {
+		a.samlServer.Close()
+	}
+}
+
+// configWarnings inspects a validated config for settings that are legal but
+// likely unintended, so that SetConfiguration dry runs can point them out
+// before an admin applies the config
+func configWarnings(c *canonicalConfig, configProto *auth.AuthConfig) []string {
+	var warnings []string
+	if configProto.LiveConfigVersion == 0 {
+		warnings = append(warnings, "live_config_version is unset, so this "+
+			"write would not detect concurrent config changes (read the current "+
+			"config with GetConfiguration and include its version to enable safe "+
+			"read-modify-write)")
+	}
+	if c.SAMLSvc != nil && c.SAMLSvc.ACSURL.Scheme != "https" {
+		warnings = append(warnings, fmt.Sprintf("SAML ACS URL %q does not use "+
+			"https, so SAML assertions (which carry user credentials) would be "+
+			"sent in cleartext", c.SAMLSvc.ACSURL))
+	}
+	for _, sink := range c.AuditSinks {
+		if sink.Webhook != nil && sink.Webhook.Scheme != "https" {
+			warnings = append(warnings, fmt.Sprintf("audit sink %q webhook URL "+
+				"%q does not use https, so audit records would be sent in "+
+				"cleartext", sink.Name, sink.Webhook))
+		}
+	}
+	return warnings
+}
+
 func (a *apiServer) getCacheConfig() *canonicalConfig {
 	a.configMu.Lock()
 	defer a.configMu.Unlock()
@@ -431,35 +853,52 @@ func (a *apiServer) getCacheConfig() *canonicalConfig {
 	return &newConfig
 }
 
+// samlSnapshot is a consistent view of the cluster's SAML configuration, as
+// returned by getSAMLSP: the auth config and the saml.ServiceProvider built
+// from it, captured together
+type samlSnapshot struct {
+	cfg *canonicalConfig
+	sp  *saml.ServiceProvider
+}
+
 // getSAMLSP returns apiServer's saml.ServiceProvider and config together, to
 // avoid a race where a SAML request is mishandled because the config is
-// modified between reading them
-func (a *apiServer) getSAMLSP() (*canonicalConfig, *saml.ServiceProvider) {
+// modified between reading them. If the cluster has no auth config, or its
+// config doesn't enable SAML, getSAMLSP returns (nil, false) and the caller
+// should reject the request (see errSAMLNotConfigured)
+func (a *apiServer) getSAMLSP() (*samlSnapshot, bool) {
 	a.configMu.Lock()
 	defer a.configMu.Unlock()
 	a.samlSPMu.Lock()
 	defer a.samlSPMu.Unlock()
-	var sp *saml.ServiceProvider
-	if a.samlSP != nil {
-		sp = a.samlSP
+	if a.configCache == nil || a.samlSP == nil {
+		return nil, false
 	}
-	var cfg *canonicalConfig
-	if a.configCache != nil {
-		cfg = a.configCache
-	}
-	// copy config to avoid data races
-	return &(*cfg), &(*sp)
+	// copy config and service provider to avoid data races
+	cfg := *a.configCache
+	sp := *a.samlSP
+	return &samlSnapshot{cfg: &cfg, sp: &sp}, true
 }
 
 // watchConfig waits for config updates in etcd and then copies new config
 // values into the confg cache
 func (a *apiServer) watchConfig() {
 	b := backoff.NewExponentialBackOff()
-	backoff.RetryNotify(func() error {
+	// configRev is the revision of the last config event applied to the cache;
+	// retries resume from it so a transient watch error doesn't re-fetch the
+	// config (and needlessly rebuild the SAML service provider)
+	var configRev int64
+	backoff.RetryUntilCancel(a.shutdownCtx, func() error {
 		// Watch for the addition/removal of new admins. Note that this will return
 		// any existing admins, so if the auth service is already activated, it will
 		// stay activated.
-		watcher, err := a.authConfig.ReadOnly(context.Background()).Watch()
+		var watcher watch.Watcher
+		var err error
+		if configRev > 0 {
+			watcher, err = a.authConfig.ReadOnly(a.shutdownCtx).WatchFrom(configRev + 1)
+		} else {
+			watcher, err = a.authConfig.ReadOnly(a.shutdownCtx).Watch()
+		}
 		if err != nil {
 			return err
 		}
@@ -471,6 +910,7 @@ func (a *apiServer) watchConfig() {
 				return errors.New("admin watch closed unexpectedly")
 			}
 			b.Reset() // event successfully received
+			a.recordWatcherEvent(configWatcher)
 
 			if a.activationState() != full {
 				return fmt.Errorf("received config event while auth not fully " +
@@ -493,12 +933,16 @@ func (a *apiServer) watchConfig() {
 				case watch.EventError:
 					return ev.Err
 				}
+				if ev.Rev > configRev {
+					configRev = ev.Rev
+				}
 				return nil // unlock configMu and samlSPMu
 			}(); err != nil {
 				return err
 			}
 		}
 	}, b, func(err error, d time.Duration) error {
+		a.recordWatcherError(configWatcher, err)
 		logrus.Errorf("error watching auth config: %v; retrying in %v", err, d)
 		return nil
 	})
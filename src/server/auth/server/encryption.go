@@ -0,0 +1,130 @@
+package server
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+)
+
+// authAtRestMagic marks etcd values written by authAtRestCodec, so that
+// plaintext values written before encryption was enabled can still be read
+var authAtRestMagic = []byte("pach-auth-enc-1\x00")
+
+// authAtRestCodec implements watch.Codec (see col.NewCollectionWithCodec) and
+// envelope-encrypts the values of sensitive auth collections (tokens,
+// authenticationCodes, and authConfig) before they're written to etcd, so
+// that a leaked etcd backup doesn't expose token hashes or IdP secrets. Each
+// value is sealed with a fresh random data key (AES-256-GCM), and the data
+// key is in turn sealed with the cluster's key-encryption key and stored
+// alongside the value.
+type authAtRestCodec struct {
+	kek []byte // the cluster's 32-byte key-encryption key
+}
+
+// newAuthAtRestCodec returns a codec that encrypts auth collection values
+// with the key in AUTH_ENCRYPTION_KEY (typically projected into the pachd pod
+// from a Kubernetes secret or a cluster KMS). If no key is configured, it
+// returns (nil, nil) and values are stored in plaintext, as before.
+func newAuthAtRestCodec(env *serviceenv.ServiceEnv) (watch.Codec, error) {
+	if env == nil || env.Configuration == nil || env.PachdSpecificConfiguration == nil {
+		return nil, nil
+	}
+	if env.AuthEncryptionKey == "" {
+		return nil, nil
+	}
+	kek, err := base64.StdEncoding.DecodeString(env.AuthEncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode AUTH_ENCRYPTION_KEY: %v", err)
+	}
+	if len(kek) != 32 {
+		return nil, fmt.Errorf("AUTH_ENCRYPTION_KEY must decode to 32 bytes "+
+			"(got %d)", len(kek))
+	}
+	return &authAtRestCodec{kek: kek}, nil
+}
+
+// gcmSeal encrypts 'plaintext' with 'key' using AES-GCM, prepending the
+// random nonce to the result
+func gcmSeal(key, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// gcmOpen reverses gcmSeal
+func gcmOpen(key, sealed []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, fmt.Errorf("encrypted value is too short (%d bytes)", len(sealed))
+	}
+	return gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+}
+
+// Encode implements watch.Codec
+func (c *authAtRestCodec) Encode(data []byte) ([]byte, error) {
+	dek := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, dek); err != nil {
+		return nil, fmt.Errorf("could not generate data key: %v", err)
+	}
+	sealedDEK, err := gcmSeal(c.kek, dek)
+	if err != nil {
+		return nil, fmt.Errorf("could not seal data key: %v", err)
+	}
+	sealedVal, err := gcmSeal(dek, data)
+	if err != nil {
+		return nil, fmt.Errorf("could not encrypt value: %v", err)
+	}
+	out := make([]byte, 0, len(authAtRestMagic)+1+len(sealedDEK)+len(sealedVal))
+	out = append(out, authAtRestMagic...)
+	out = append(out, byte(len(sealedDEK)))
+	out = append(out, sealedDEK...)
+	out = append(out, sealedVal...)
+	return out, nil
+}
+
+// Decode implements watch.Codec
+func (c *authAtRestCodec) Decode(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, authAtRestMagic) {
+		// plaintext value written before encryption was enabled
+		return data, nil
+	}
+	rest := data[len(authAtRestMagic):]
+	if len(rest) < 1 {
+		return nil, fmt.Errorf("truncated encrypted value")
+	}
+	dekLen := int(rest[0])
+	rest = rest[1:]
+	if len(rest) < dekLen {
+		return nil, fmt.Errorf("truncated encrypted value")
+	}
+	dek, err := gcmOpen(c.kek, rest[:dekLen])
+	if err != nil {
+		return nil, fmt.Errorf("could not unseal data key (was "+
+			"AUTH_ENCRYPTION_KEY changed?): %v", err)
+	}
+	return gcmOpen(dek, rest[dekLen:])
+}
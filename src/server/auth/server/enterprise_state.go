@@ -0,0 +1,160 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	enterpriseclient "github.com/pachyderm/pachyderm/src/client/enterprise"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
+
+	logrus "github.com/sirupsen/logrus"
+)
+
+const (
+	// enterpriseStateTTL is how long a fetched enterprise state is served
+	// without revalidation. Expired entries are still served (stale-while-
+	// revalidate) while a background fetch replaces them, so an Enterprise
+	// service hiccup doesn't fail authorized traffic
+	enterpriseStateTTL = 15 * time.Second
+
+	// enterpriseStateBreakerThreshold is the number of consecutive refresh
+	// failures after which the circuit breaker opens and refreshes pause
+	enterpriseStateBreakerThreshold = 3
+
+	// enterpriseStateBreakerCooldown is how long the circuit breaker stays
+	// open before another refresh is attempted
+	enterpriseStateBreakerCooldown = time.Minute
+
+	// enterpriseStateFetchTimeout bounds each background refresh RPC
+	enterpriseStateFetchTimeout = 30 * time.Second
+)
+
+// enterpriseStateCache holds the auth server's view of the cluster's
+// enterprise activation state, so that the authorization paths (which all
+// consult this state) don't make an Enterprise.GetState RPC per request
+type enterpriseStateCache struct {
+	mu sync.Mutex
+
+	// state is the last successfully-fetched enterprise state; it's only
+	// meaningful if 'fetchedAt' is nonzero
+	state     enterpriseclient.State
+	fetchedAt time.Time
+
+	// failures counts consecutive refresh failures; once it reaches
+	// enterpriseStateBreakerThreshold the breaker opens until 'openUntil'
+	failures  int
+	openUntil time.Time
+
+	// refreshing is set while a background refresh is in flight, so stale
+	// reads don't pile up duplicate refresh goroutines
+	refreshing bool
+}
+
+// noteSuccess records a successful fetch of 'state' (must be called with
+// c.mu held)
+func (c *enterpriseStateCache) noteSuccess(state enterpriseclient.State) {
+	c.state = state
+	c.fetchedAt = time.Now()
+	c.failures = 0
+	c.openUntil = time.Time{}
+}
+
+// noteFailure records a failed fetch, opening the circuit breaker if too many
+// failures have accumulated (must be called with c.mu held)
+func (c *enterpriseStateCache) noteFailure() {
+	c.failures++
+	if c.failures >= enterpriseStateBreakerThreshold {
+		c.openUntil = time.Now().Add(enterpriseStateBreakerCooldown)
+		c.failures = 0
+	}
+}
+
+// fetchEnterpriseState makes the Enterprise.GetState RPC directly, bypassing
+// the cache. Callers outside this file should use getEnterpriseTokenState
+func (a *apiServer) fetchEnterpriseState(ctx context.Context) (enterpriseclient.State, error) {
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/auth.server/GetEnterpriseState")
+	defer tracing.FinishAnySpan(span)
+	pachClient := a.env.GetPachClient(ctx)
+	resp, err := pachClient.Enterprise.GetState(ctx,
+		&enterpriseclient.GetStateRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("could not get Enterprise status: %v", grpcutil.ScrubGRPC(err))
+	}
+	return resp.State, nil
+}
+
+// getEnterpriseTokenState returns the cluster's enterprise activation state,
+// serving a cached value where possible. A stale cached value is served
+// immediately while one background goroutine revalidates it; only the very
+// first call (or a call while the circuit breaker is open with nothing
+// cached) waits on the Enterprise service
+func (a *apiServer) getEnterpriseTokenState(ctx context.Context) (enterpriseclient.State, error) {
+	c := &a.enterpriseState
+	c.mu.Lock()
+	now := time.Now()
+	switch {
+	case !c.fetchedAt.IsZero() && now.Sub(c.fetchedAt) < enterpriseStateTTL:
+		// cache is fresh
+		state := c.state
+		c.mu.Unlock()
+		return state, nil
+	case !c.fetchedAt.IsZero():
+		// cache is stale--serve it anyway, and revalidate in the background
+		// unless the breaker is open or a refresh is already running
+		state := c.state
+		if now.After(c.openUntil) && !c.refreshing {
+			c.refreshing = true
+			go a.refreshEnterpriseState()
+		}
+		c.mu.Unlock()
+		return state, nil
+	case now.Before(c.openUntil):
+		// nothing cached and the breaker is open--fail without another RPC
+		wait := c.openUntil.Sub(now).Round(time.Second)
+		c.mu.Unlock()
+		return 0, fmt.Errorf("could not get Enterprise status: the Enterprise "+
+			"service has been unreachable for several attempts; retrying in %v", wait)
+	}
+	c.mu.Unlock()
+
+	// nothing cached--fetch synchronously
+	state, err := a.fetchEnterpriseState(ctx)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		c.noteFailure()
+		a.recordWatcherError(enterpriseStateFetcher, err)
+		return 0, err
+	}
+	c.noteSuccess(state)
+	a.recordWatcherEvent(enterpriseStateFetcher)
+	return state, nil
+}
+
+// refreshEnterpriseState revalidates the enterprise state cache in the
+// background (it's spawned by getEnterpriseTokenState when a stale entry is
+// served)
+func (a *apiServer) refreshEnterpriseState() {
+	ctx, cancel := context.WithTimeout(a.shutdownCtx, enterpriseStateFetchTimeout)
+	defer cancel()
+	state, err := a.fetchEnterpriseState(ctx)
+
+	c := &a.enterpriseState
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.refreshing = false
+	if err != nil {
+		c.noteFailure()
+		a.recordWatcherError(enterpriseStateFetcher, err)
+		if a.shutdownCtx.Err() == nil {
+			logrus.Errorf("could not refresh cached Enterprise state (stale "+
+				"state will be served): %v", err)
+		}
+		return
+	}
+	c.noteSuccess(state)
+	a.recordWatcherEvent(enterpriseStateFetcher)
+}
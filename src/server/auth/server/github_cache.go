@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/github"
+
+	authclient "github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
+)
+
+const (
+	// githubUserCacheSize bounds the number of canonicalized GitHub usernames
+	// the auth server remembers (each entry is two small strings, so this is
+	// generous)
+	githubUserCacheSize = 1024
+
+	// githubUserCacheTTL is how long a successful canonicalization is served
+	// from the cache. GitHub logins can be renamed, so entries do expire, but
+	// renames are rare enough that this can be long
+	githubUserCacheTTL = time.Hour
+
+	// githubUserNegativeTTL is how long a "no such user" result is served from
+	// the cache. It's much shorter than githubUserCacheTTL, as the likeliest
+	// cause is a typo that the caller is about to correct
+	githubUserNegativeTTL = 5 * time.Minute
+)
+
+// githubUserCacheEntry is the value stored in apiServer.githubUsers. If
+// 'notFound' is set, GitHub reported that the user doesn't exist, and
+// 'canonical' is unset (negative caching, so repeated lookups of a bad
+// username don't each cost an API call)
+type githubUserCacheEntry struct {
+	canonical string
+	notFound  bool
+	expires   time.Time
+}
+
+// canonicalizeGitHubUsername corrects 'user' for case errors by looking
+// up the corresponding user's GitHub profile and extracting their login ID
+// from that. 'user' should not have any subject prefixes (as they are required
+// to be a GitHub user). Results--including "no such user"--are cached, so
+// e.g. a SetACL call with many GitHub principals doesn't hit GitHub's rate
+// limit
+func (a *apiServer) canonicalizeGitHubUsername(ctx context.Context, user string) (string, error) {
+	if strings.Index(user, ":") >= 0 {
+		return "", fmt.Errorf("invalid username has multiple prefixes: %s%s", authclient.GitHubPrefix, user)
+	}
+	if os.Getenv(DisableAuthenticationEnvVar) == "true" {
+		// authentication is off -- user might not even be real
+		return authclient.GitHubPrefix + user, nil
+	}
+	if config := a.getCacheConfig(); config != nil && config.OfflineCanonicalization {
+		// remote canonicalization is disabled (e.g. an air-gapped cluster
+		// where the GitHub API is unreachable)--take the username literally
+		return authclient.GitHubPrefix + user, nil
+	}
+	login := strings.ToLower(user)
+	if v, ok := a.githubUsers.Get(login); ok {
+		if entry := v.(*githubUserCacheEntry); time.Now().Before(entry.expires) {
+			if entry.notFound {
+				return "", fmt.Errorf("error canonicalizing \"%s\": GitHub user does not exist", user)
+			}
+			return entry.canonical, nil
+		}
+		a.githubUsers.Remove(login) // expired
+	}
+
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/auth.github/GetUser", "user", login)
+	defer tracing.FinishAnySpan(span)
+	gclient := github.NewClient(http.DefaultClient)
+	u, resp, err := gclient.Users.Get(ctx, login)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			a.githubUsers.Add(login, &githubUserCacheEntry{
+				notFound: true,
+				expires:  time.Now().Add(githubUserNegativeTTL),
+			})
+			return "", fmt.Errorf("error canonicalizing \"%s\": GitHub user does not exist", user)
+		}
+		// transient errors (network, rate limit, etc.) aren't cached
+		return "", fmt.Errorf("error canonicalizing \"%s\": %v", user, err)
+	}
+	canonical := authclient.GitHubPrefix + u.GetLogin()
+	a.githubUsers.Add(login, &githubUserCacheEntry{
+		canonical: canonical,
+		expires:   time.Now().Add(githubUserCacheTTL),
+	})
+	return canonical, nil
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// networkPolicyAuthenticateRPCs is the set of gRPC methods gated by the
+// authenticate_allow_cidrs list in the cluster's auth config: the RPCs by
+// which a caller can convert an external credential into a Pachyderm token
+var networkPolicyAuthenticateRPCs = map[string]bool{
+	"/auth.API/Authenticate":       true,
+	"/auth.API/GetOneTimePassword": true,
+}
+
+// networkPolicyAdminRPCs is the set of gRPC methods gated by the
+// admin_allow_cidrs list in the cluster's auth config: the RPCs that
+// administer the auth system itself
+var networkPolicyAdminRPCs = map[string]bool{
+	"/auth.API/Activate":         true,
+	"/auth.API/Deactivate":       true,
+	"/auth.API/ModifyAdmins":     true,
+	"/auth.API/SetConfiguration": true,
+	"/auth.API/ExtractAuthState": true,
+	"/auth.API/RestoreAuthState": true,
+	"/auth.API/Fsck":             true,
+}
+
+// NetworkPolicyEnforcer is a gRPC interceptor that enforces the CIDR
+// allowlists in the cluster's auth config (see
+// AuthConfig.NetworkPolicyOptions). It's created before pachd's gRPC server
+// (interceptors must be passed to grpc.NewServer) and bound to the auth API
+// server once that exists; until then, or if no network policy is configured,
+// it allows everything
+type NetworkPolicyEnforcer struct {
+	mu     sync.Mutex // guards 'server'
+	server APIServer
+}
+
+// NewNetworkPolicyEnforcer returns an unbound NetworkPolicyEnforcer (see
+// Bind)
+func NewNetworkPolicyEnforcer() *NetworkPolicyEnforcer {
+	return &NetworkPolicyEnforcer{}
+}
+
+// Bind attaches 'server' (which must have been returned by NewAuthServer) to
+// 'e', so that e's interceptors enforce the network policy in the auth config
+// that 'server' watches
+func (e *NetworkPolicyEnforcer) Bind(server APIServer) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.server = server
+}
+
+// UnaryInterceptor enforces the cluster's network policy on unary RPCs
+func (e *NetworkPolicyEnforcer) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := e.check(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor enforces the cluster's network policy on streaming RPCs
+func (e *NetworkPolicyEnforcer) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := e.check(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// check returns an error if the peer address in 'ctx' is not allowed to call
+// 'fullMethod' under the cluster's network policy
+func (e *NetworkPolicyEnforcer) check(ctx context.Context, fullMethod string) error {
+	var cidrs []*net.IPNet
+	switch {
+	case networkPolicyAuthenticateRPCs[fullMethod]:
+		cidrs = e.allowedCIDRs(ctx, func(policy *canonicalNetworkPolicyConfig) []*net.IPNet {
+			return policy.AuthenticateAllowCIDRs
+		})
+	case networkPolicyAdminRPCs[fullMethod]:
+		cidrs = e.allowedCIDRs(ctx, func(policy *canonicalNetworkPolicyConfig) []*net.IPNet {
+			return policy.AdminAllowCIDRs
+		})
+	}
+	if len(cidrs) == 0 {
+		return nil // method isn't gated, or no policy is configured for it
+	}
+	peerInfo, ok := peer.FromContext(ctx)
+	if !ok {
+		return fmt.Errorf("rejecting %s: could not determine peer address", fullMethod)
+	}
+	host, _, err := net.SplitHostPort(peerInfo.Addr.String())
+	if err != nil {
+		host = peerInfo.Addr.String() // address without a port (e.g. a pipe)
+	}
+	peerIP := net.ParseIP(host)
+	if peerIP != nil {
+		for _, cidr := range cidrs {
+			if cidr.Contains(peerIP) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("%s is not permitted from %q by this cluster's network policy", fullMethod, peerInfo.Addr.String())
+}
+
+// allowedCIDRs extracts one of the network policy's CIDR lists from the
+// cached config of the auth domain handling the request in 'ctx' (nil if no
+// policy is configured). An unresolvable tenant yields no policy here; the
+// request is rejected when the router fails to resolve it again
+func (e *NetworkPolicyEnforcer) allowedCIDRs(ctx context.Context, get func(*canonicalNetworkPolicyConfig) []*net.IPNet) []*net.IPNet {
+	e.mu.Lock()
+	server := e.server
+	e.mu.Unlock()
+	if server == nil {
+		return nil
+	}
+	a, err := apiServerForRequest(ctx, server)
+	if err != nil || a == nil {
+		return nil
+	}
+	cfg := a.getCacheConfig()
+	if cfg == nil || cfg.NetworkPolicy == nil {
+		return nil
+	}
+	return get(cfg.NetworkPolicy)
+}
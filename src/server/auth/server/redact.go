@@ -0,0 +1,148 @@
+package server
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	protodesc "github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+
+	authclient "github.com/pachyderm/pachyderm/src/client/auth"
+)
+
+// redactedPlaceholder replaces the values of fields annotated with
+// (redact) = true in auth.proto when requests/responses are logged, so that
+// logs keep the structure of the message without leaking credentials
+const redactedPlaceholder = "[REDACTED]"
+
+// redactedFieldCache caches, per message type, the numbers of the fields
+// annotated with (redact) = true (computing them requires decompressing the
+// message's proto descriptor, which is too slow to do on every log line)
+var redactedFieldCache sync.Map // map[reflect.Type]map[int32]bool
+
+// redactExtension mirrors authclient.E_Redact as a gogo/protobuf extension
+// descriptor: message descriptors unmarshalled by protodesc.ForMessage store
+// their extensions in gogo form, which the golang/protobuf descriptor in the
+// generated code can't read
+var redactExtension = &proto.ExtensionDesc{
+	ExtendedType:  (*protodesc.FieldOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         authclient.E_Redact.Field,
+	Name:          authclient.E_Redact.Name,
+	Tag:           authclient.E_Redact.Tag,
+	Filename:      authclient.E_Redact.Filename,
+}
+
+// redactedFieldNums returns the proto field numbers of the fields of 'msg'
+// that are annotated with (redact) = true
+func redactedFieldNums(msg protodesc.Message) map[int32]bool {
+	t := reflect.TypeOf(msg)
+	if cached, ok := redactedFieldCache.Load(t); ok {
+		return cached.(map[int32]bool)
+	}
+	fieldNums := make(map[int32]bool)
+	_, md := protodesc.ForMessage(msg)
+	for _, f := range md.GetField() {
+		if f.Options == nil {
+			continue
+		}
+		ext, err := proto.GetExtension(f.Options, redactExtension)
+		if err != nil {
+			continue
+		}
+		if redact, ok := ext.(*bool); ok && *redact {
+			fieldNums[f.GetNumber()] = true
+		}
+	}
+	redactedFieldCache.Store(t, fieldNums)
+	return fieldNums
+}
+
+// redactValue overwrites the (string, bytes, or repeated string) field 'field'
+// with redactedPlaceholder. Empty fields are left alone, so that readers can
+// still tell which fields of a request were set
+func redactValue(field reflect.Value) {
+	switch field.Kind() {
+	case reflect.String:
+		if field.String() != "" {
+			field.SetString(redactedPlaceholder)
+		}
+	case reflect.Slice:
+		switch field.Type().Elem().Kind() {
+		case reflect.Uint8: // bytes
+			if field.Len() > 0 {
+				field.SetBytes([]byte(redactedPlaceholder))
+			}
+		case reflect.String: // repeated string
+			for i := 0; i < field.Len(); i++ {
+				field.Index(i).SetString(redactedPlaceholder)
+			}
+		}
+	}
+}
+
+// redactMessage overwrites, in place, every field of 'msg' (or of a message
+// nested within it) that's annotated with (redact) = true in auth.proto
+func redactMessage(msg proto.Message) {
+	v := reflect.ValueOf(msg)
+	if !v.IsValid() || v.Kind() != reflect.Ptr || v.IsNil() {
+		return
+	}
+	v = v.Elem()
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	var redacted map[int32]bool
+	if dm, ok := msg.(protodesc.Message); ok {
+		redacted = redactedFieldNums(dm)
+	}
+	props := proto.GetProperties(v.Type())
+	for i := 0; i < v.NumField(); i++ {
+		if strings.HasPrefix(v.Type().Field(i).Name, "XXX_") {
+			continue
+		}
+		field := v.Field(i)
+		if redacted[int32(props.Prop[i].Tag)] {
+			redactValue(field)
+			continue
+		}
+		// recurse into nested messages (e.g. the AuthConfig inside a
+		// SetConfigurationRequest) to find annotated fields within
+		switch field.Kind() {
+		case reflect.Ptr:
+			if nested, ok := field.Interface().(proto.Message); ok {
+				redactMessage(nested)
+			}
+		case reflect.Slice:
+			for j := 0; j < field.Len(); j++ {
+				if nested, ok := field.Index(j).Interface().(proto.Message); ok {
+					redactMessage(nested)
+				}
+			}
+		case reflect.Map:
+			for _, key := range field.MapKeys() {
+				if nested, ok := field.MapIndex(key).Interface().(proto.Message); ok {
+					redactMessage(nested)
+				}
+			}
+		}
+	}
+}
+
+// redactedClone returns a copy of the request/response 'msg' with every field
+// annotated with (redact) = true in auth.proto replaced with
+// redactedPlaceholder. Values that aren't proto messages are returned
+// unchanged
+func redactedClone(msg interface{}) interface{} {
+	pm, ok := msg.(proto.Message)
+	if !ok {
+		return msg
+	}
+	if v := reflect.ValueOf(pm); !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return msg
+	}
+	clone := proto.Clone(pm)
+	redactMessage(clone)
+	return clone
+}
@@ -2,7 +2,9 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -10,10 +12,26 @@ import (
 	"time"
 
 	"github.com/crewjam/saml"
+	logrus "github.com/sirupsen/logrus"
 
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
 )
 
+// samlServeRetryInterval is how long serveSAML waits before re-listening
+// after an unexpected serving error
+const samlServeRetryInterval = time.Second
+
+// spInitiatedRequestTTL is how long an SP-initiated AuthnRequest (issued by
+// the /saml/login handler) is remembered while the user authenticates with
+// the ID provider
+const spInitiatedRequestTTL = 5 * time.Minute
+
+// errSAMLNotConfigured is returned (with a 409) by the SAML HTTP handlers if
+// no SAML ID provider is set in this cluster's auth config
+const errSAMLNotConfigured = "SAML is not configured on this cluster (set a " +
+	"SAML ID provider and saml_svc_options in the auth config)"
+
 var defaultDashRedirectURL = &url.URL{
 	Scheme: "http",
 	Host:   "localhost:30080",
@@ -21,31 +39,152 @@ var defaultDashRedirectURL = &url.URL{
 	Path: path.Join("/", "auth", "autologin"),
 }
 
+// samlLoginState records one outstanding SP-initiated AuthnRequest: which
+// request ID the ACS should accept, where to redirect the user's browser with
+// their auth code once the IdP responds, and when the request expires. States
+// are keyed by the request's RelayState in a.samlRequests
+type samlLoginState struct {
+	requestID  string
+	redirect   *url.URL // if nil, the ACS falls back to the dash redirect
+	expiration time.Time
+}
+
+// recordLoginRequest remembers an outstanding SP-initiated AuthnRequest under
+// 'relayState', so that the ACS will accept the IdP's response to it (expired
+// requests are pruned on each call)
+func (a *apiServer) recordLoginRequest(relayState string, state *samlLoginState) {
+	a.samlRequestsMu.Lock()
+	defer a.samlRequestsMu.Unlock()
+	now := time.Now()
+	for oldState, loginState := range a.samlRequests {
+		if now.After(loginState.expiration) {
+			delete(a.samlRequests, oldState)
+		}
+	}
+	a.samlRequests[relayState] = state
+}
+
+// takeLoginRequest removes and returns the SP-initiated login state recorded
+// under 'relayState', or nil if there is none (unknown, expired, or already
+// consumed--each AuthnRequest can be answered at most once)
+func (a *apiServer) takeLoginRequest(relayState string) *samlLoginState {
+	a.samlRequestsMu.Lock()
+	defer a.samlRequestsMu.Unlock()
+	state, ok := a.samlRequests[relayState]
+	if !ok {
+		return nil
+	}
+	delete(a.samlRequests, relayState)
+	if time.Now().After(state.expiration) {
+		return nil
+	}
+	return state
+}
+
+// validateLoginRedirect checks that 'redirect' (the 'redirect' parameter of
+// an SP-initiated login request) points at a loopback address, where pachctl
+// listens for the user's auth code. Anything else is rejected so that
+// /saml/login can't be used as an open redirect
+func validateLoginRedirect(redirect string) (*url.URL, *errutil.HTTPError) {
+	u, err := url.Parse(redirect)
+	if err != nil {
+		return nil, errutil.NewHTTPError(http.StatusBadRequest, "could not parse redirect URL: %v", err)
+	}
+	if u.Scheme != "http" {
+		return nil, errutil.NewHTTPError(http.StatusBadRequest, "redirect URL must use http")
+	}
+	switch u.Hostname() {
+	case "localhost", "127.0.0.1", "::1":
+		return u, nil
+	}
+	return nil, errutil.NewHTTPError(http.StatusBadRequest, "redirect URL must point at localhost")
+}
+
+// handleSPInitiatedLogin is the HTTP handler for /saml/login: it generates a
+// new AuthnRequest, remembers its ID (so that the ACS will accept the IdP's
+// response) and redirects the user's browser to the IdP's login page. It's
+// the entry point for 'pachctl auth login --idp', which passes the localhost
+// callback where it receives the user's auth code in 'redirect'
+func (a *apiServer) handleSPInitiatedLogin(w http.ResponseWriter, req *http.Request) {
+	var err *errutil.HTTPError
+
+	logRequest := "SAML SP-initiated login request"
+	a.LogReq(logRequest)
+	defer func(start time.Time) {
+		a.LogResp(logRequest, errutil.PrettyPrintCode(err), err, time.Since(start))
+	}(time.Now())
+
+	snapshot, ok := a.getSAMLSP()
+	if !ok {
+		http.Error(w, errSAMLNotConfigured, http.StatusConflict)
+		return
+	}
+	var redirect *url.URL
+	if redirectParam := req.URL.Query().Get("redirect"); redirectParam != "" {
+		if redirect, err = validateLoginRedirect(redirectParam); err != nil {
+			http.Error(w, err.Error(), err.Code())
+			return
+		}
+	}
+	ssoURL := snapshot.sp.GetSSOBindingLocation(saml.HTTPRedirectBinding)
+	if ssoURL == "" {
+		err = errutil.NewHTTPError(http.StatusConflict, "could not initiate "+
+			"login: this cluster's SAML ID provider metadata has no HTTP-Redirect "+
+			"single sign-on binding")
+		http.Error(w, err.Error(), err.Code())
+		return
+	}
+	authnReq, reqErr := snapshot.sp.MakeAuthenticationRequest(ssoURL)
+	if reqErr != nil {
+		err = errutil.NewHTTPError(http.StatusInternalServerError,
+			"could not create SAML AuthnRequest: %v", reqErr)
+		http.Error(w, err.Error(), err.Code())
+		return
+	}
+	relayState := uuid.NewWithoutDashes()
+	a.recordLoginRequest(relayState, &samlLoginState{
+		requestID:  authnReq.ID,
+		redirect:   redirect,
+		expiration: time.Now().Add(spInitiatedRequestTTL),
+	})
+	w.Header().Set("Location", authnReq.Redirect(relayState).String())
+	w.WriteHeader(http.StatusFound) // Send redirect
+}
+
 // handleSAMLResponseInternal is a helper function called by handleSAMLResponse
-func (a *apiServer) handleSAMLResponseInternal(cfg *canonicalConfig, sp *saml.ServiceProvider, req *http.Request) (string, string, *errutil.HTTPError) {
+func (a *apiServer) handleSAMLResponseInternal(cfg *canonicalConfig, sp *saml.ServiceProvider, req *http.Request) (string, string, *url.URL, *errutil.HTTPError) {
 	if err := req.ParseForm(); err != nil {
-		return "", "", errutil.NewHTTPError(http.StatusConflict, "Could not parse request form: %v", err)
+		return "", "", nil, errutil.NewHTTPError(http.StatusConflict, "Could not parse request form: %v", err)
+	}
+	// An empty request ID allows IdP-initiated auth; responses to SP-initiated
+	// requests (pachctl login) must match the ID of an AuthnRequest issued by
+	// /saml/login, looked up via the response's RelayState
+	possibleRequestIDs := []string{""}
+	var loginState *samlLoginState
+	if relayState := req.PostForm.Get("RelayState"); relayState != "" {
+		if loginState = a.takeLoginRequest(relayState); loginState != nil {
+			possibleRequestIDs = append(possibleRequestIDs, loginState.requestID)
+		}
 	}
-	// No possible request IDs b/c only IdP-initiated auth is implemented for now
-	assertion, err := sp.ParseResponse(req, []string{""})
+	assertion, err := sp.ParseResponse(req, possibleRequestIDs)
 	if err != nil {
 		errMsg := fmt.Sprintf("Error parsing SAML response: %v", err)
 		if invalidRespErr, ok := err.(*saml.InvalidResponseError); ok {
 			errMsg += "\n(" + invalidRespErr.PrivateErr.Error() + ")"
 		}
-		return "", "", errutil.NewHTTPError(http.StatusBadRequest, errMsg)
+		return "", "", nil, errutil.NewHTTPError(http.StatusBadRequest, errMsg)
 	}
 
 	// Make sure all the fields we need are present (avoid segfault)
 	switch {
 	case assertion == nil:
-		return "", "", errutil.NewHTTPError(http.StatusConflict, "Error parsing SAML response: assertion is nil")
+		return "", "", nil, errutil.NewHTTPError(http.StatusConflict, "Error parsing SAML response: assertion is nil")
 	case assertion.Subject == nil:
-		return "", "", errutil.NewHTTPError(http.StatusConflict, "Error parsing SAML response: assertion.Subject is nil")
+		return "", "", nil, errutil.NewHTTPError(http.StatusConflict, "Error parsing SAML response: assertion.Subject is nil")
 	case assertion.Subject.NameID == nil:
-		return "", "", errutil.NewHTTPError(http.StatusConflict, "Error parsing SAML response: assertion.Subject.NameID is nil")
+		return "", "", nil, errutil.NewHTTPError(http.StatusConflict, "Error parsing SAML response: assertion.Subject.NameID is nil")
 	case assertion.Subject.NameID.Value == "":
-		return "", "", errutil.NewHTTPError(http.StatusConflict, "Error parsing SAML response: assertion.Subject.NameID.Value is unset")
+		return "", "", nil, errutil.NewHTTPError(http.StatusConflict, "Error parsing SAML response: assertion.Subject.NameID.Value is unset")
 	}
 
 	// User is successfully authenticated
@@ -57,20 +196,29 @@ func (a *apiServer) handleSAMLResponseInternal(cfg *canonicalConfig, sp *saml.Se
 		}
 	}
 	if samlIDP == nil {
-		return "", "", errutil.NewHTTPError(http.StatusInternalServerError,
+		return "", "", nil, errutil.NewHTTPError(http.StatusInternalServerError,
 			"Error processing SAML assertion: assertion appears valid, but could "+
 				"not find configuration for SAML ID provider")
 	}
 	subject := fmt.Sprintf("%s:%s", samlIDP.Name, assertion.Subject.NameID.Value)
 
-	// Get new OTP for user (exp. from config if set, or default session duration)
+	// Get new OTP for user (exp. from config if set, or default session
+	// duration). Per-IdP settings override the SAML service's global settings,
+	// so that e.g. contractor IdPs can issue shorter sessions than employee
+	// IdPs
 	expiration := time.Now().Add(time.Duration(defaultSAMLTTLSecs) * time.Second)
+	if samlIDP.DefaultTokenTTL != 0 {
+		expiration = time.Now().Add(samlIDP.DefaultTokenTTL)
+	}
 	if cfg.SAMLSvc.SessionDuration != 0 {
 		expiration = time.Now().Add(cfg.SAMLSvc.SessionDuration)
 	}
+	if samlIDP.SessionDuration != 0 {
+		expiration = time.Now().Add(samlIDP.SessionDuration)
+	}
 	authCode, err := a.getOneTimePassword(req.Context(), subject, expiration)
 	if err != nil {
-		return "", "", errutil.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return "", "", nil, errutil.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
 	// Update group memberships
@@ -87,13 +235,17 @@ func (a *apiServer) handleSAMLResponseInternal(cfg *canonicalConfig, sp *saml.Se
 					groups = append(groups, fmt.Sprintf("group/%s:%s", samlIDP.Name, v.Value))
 				}
 				if err := a.setGroupsForUserInternal(context.Background(), subject, groups); err != nil {
-					return "", "", errutil.NewHTTPError(http.StatusInternalServerError, err.Error())
+					return "", "", nil, errutil.NewHTTPError(http.StatusInternalServerError, err.Error())
 				}
 			}
 		}
 	}
 
-	return subject, authCode, nil
+	var redirect *url.URL
+	if loginState != nil {
+		redirect = loginState.redirect
+	}
+	return subject, authCode, redirect, nil
 }
 
 // handleSAMLResponse is the HTTP handler for Pachyderm's ACS, which receives
@@ -101,6 +253,7 @@ func (a *apiServer) handleSAMLResponseInternal(cfg *canonicalConfig, sp *saml.Se
 // configured)
 func (a *apiServer) handleSAMLResponse(w http.ResponseWriter, req *http.Request) {
 	var subject, authCode string
+	var redirect *url.URL
 	var err *errutil.HTTPError
 
 	logRequest := "SAML login request"
@@ -112,25 +265,25 @@ func (a *apiServer) handleSAMLResponse(w http.ResponseWriter, req *http.Request)
 		a.LogResp(logRequest, errutil.PrettyPrintCode(err), err, time.Since(start))
 	}(time.Now())
 
-	cfg, sp := a.getSAMLSP()
-	if cfg == nil {
-		http.Error(w, "auth has no active config (either never set or disabled)", http.StatusConflict)
-		return
-	}
-	if sp == nil {
-		http.Error(w, "SAML ACS has not been configured or was disabled", http.StatusConflict)
+	snapshot, ok := a.getSAMLSP()
+	if !ok {
+		http.Error(w, errSAMLNotConfigured, http.StatusConflict)
 		return
 	}
-	subject, authCode, err = a.handleSAMLResponseInternal(cfg, sp, req)
+	subject, authCode, redirect, err = a.handleSAMLResponseInternal(snapshot.cfg, snapshot.sp, req)
 	if err != nil {
 		http.Error(w, err.Error(), err.Code())
 		return
 	}
 
-	// Redirect caller back to dash with auth code
+	// Redirect caller back to an SP-initiated login's localhost callback
+	// (pachctl), or to dash, with their auth code
 	u := *defaultDashRedirectURL
-	if cfg.SAMLSvc != nil && cfg.SAMLSvc.DashURL != nil {
-		u = *cfg.SAMLSvc.DashURL
+	if snapshot.cfg.SAMLSvc != nil && snapshot.cfg.SAMLSvc.DashURL != nil {
+		u = *snapshot.cfg.SAMLSvc.DashURL
+	}
+	if redirect != nil {
+		u = *redirect
 	}
 	u.RawQuery = url.Values{"auth_code": []string{authCode}}.Encode()
 	w.Header().Set("Location", u.String())
@@ -138,20 +291,103 @@ func (a *apiServer) handleSAMLResponse(w http.ResponseWriter, req *http.Request)
 }
 
 func (a *apiServer) handleMetadata(w http.ResponseWriter, req *http.Request) {
-	a.samlSPMu.Lock()
-	defer a.samlSPMu.Unlock()
-	buf, _ := xml.MarshalIndent(a.samlSP.Metadata(), "", "  ")
+	snapshot, ok := a.getSAMLSP()
+	if !ok {
+		http.Error(w, errSAMLNotConfigured, http.StatusConflict)
+		return
+	}
+	buf, _ := xml.MarshalIndent(snapshot.sp.Metadata(), "", "  ")
 	w.Header().Set("Content-Type", "application/samlmetadata+xml")
 	w.Write(buf)
 	return
 }
 
+// samlListenAddr returns the address on which the SAML services listen. The
+// port and bind address can be overridden via the SAML_PORT and
+// SAML_LISTEN_ADDRESS environment variables for deployments behind
+// non-standard ingress setups (the default is ":654"--see SamlPort)
+func (a *apiServer) samlListenAddr() string {
+	port := uint16(SamlPort)
+	var host string
+	if a.env.Configuration != nil && a.env.PachdSpecificConfiguration != nil {
+		if a.env.SamlPort != 0 {
+			port = a.env.SamlPort
+		}
+		host = a.env.SamlListenAddress
+	}
+	return fmt.Sprintf("%s:%d", host, port)
+}
+
+// samlTLSFiles returns the TLS cert/key pair with which the SAML services
+// should currently be served ("" if they should be served over plain HTTP)
+func (a *apiServer) samlTLSFiles() (certFile, keyFile string) {
+	cfg := a.getCacheConfig()
+	if cfg == nil || cfg.SAMLSvc == nil {
+		return "", ""
+	}
+	return cfg.SAMLSvc.TLSCertFile, cfg.SAMLSvc.TLSKeyFile
+}
+
 func (a *apiServer) serveSAML() {
 	samlMux := http.NewServeMux()
 	samlMux.HandleFunc("/saml/acs", a.handleSAMLResponse)
+	samlMux.HandleFunc("/saml/login", a.handleSPInitiatedLogin)
 	samlMux.HandleFunc("/saml/metadata", a.handleMetadata)
 	samlMux.HandleFunc("/*", func(w http.ResponseWriter, req *http.Request) {
 		w.WriteHeader(http.StatusTeapot)
 	})
-	http.ListenAndServe(fmt.Sprintf(":%d", SamlPort), samlMux)
+	// Serve in a loop: when TLS is enabled or disabled in the auth config,
+	// setCacheConfig closes the running server and this loop re-listens with
+	// the new settings. Certificate *rotation* doesn't require a restart, as
+	// the cert/key files are re-read on every TLS handshake
+	for {
+		server := &http.Server{Addr: a.samlListenAddr(), Handler: samlMux}
+		certFile, _ := a.samlTLSFiles()
+		useTLS := certFile != ""
+		func() {
+			a.samlServerMu.Lock()
+			defer a.samlServerMu.Unlock()
+			a.samlServer = server
+			a.samlServerTLS = useTLS
+		}()
+		a.recordWatcherEvent(samlService)
+		var err error
+		if useTLS {
+			server.TLSConfig = &tls.Config{
+				GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+					certFile, keyFile := a.samlTLSFiles()
+					if certFile == "" {
+						return nil, errors.New("no TLS certificate configured for the SAML service")
+					}
+					certPEM, err := readConfigFile(a.env, certFile)
+					if err != nil {
+						return nil, fmt.Errorf("could not load SAML TLS certificate: %v", err)
+					}
+					keyPEM, err := readConfigFile(a.env, keyFile)
+					if err != nil {
+						return nil, fmt.Errorf("could not load SAML TLS key: %v", err)
+					}
+					cert, err := tls.X509KeyPair(certPEM, keyPEM)
+					if err != nil {
+						return nil, fmt.Errorf("could not load SAML TLS certificate: %v", err)
+					}
+					return &cert, nil
+				},
+			}
+			err = server.ListenAndServeTLS("", "")
+		} else {
+			err = server.ListenAndServe()
+		}
+		if a.shutdownCtx.Err() != nil {
+			return // the server was closed for shutdown, not a config change
+		}
+		if err == http.ErrServerClosed {
+			continue // TLS was enabled or disabled--re-listen with new settings
+		}
+		// surface the error in AuthHealth--otherwise the SAML service is just
+		// silently down
+		a.recordWatcherError(samlService, err)
+		logrus.Errorf("error serving SAML: %v; retrying in %v", err, samlServeRetryInterval)
+		time.Sleep(samlServeRetryInterval)
+	}
 }
@@ -0,0 +1,507 @@
+package server
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+
+	authclient "github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
+	txnenv "github.com/pachyderm/pachyderm/src/server/pkg/transactionenv"
+)
+
+// tenantsEtcdPrefix is the etcd prefix (under the auth etcd prefix) below
+// which each configured tenant's auth collections are rooted, e.g.
+// <auth prefix>/tenants/<tenant>/tokens. The default domain keeps the auth
+// prefix itself, so enabling tenants doesn't move existing clusters' data
+const tenantsEtcdPrefix = "tenants"
+
+// validTenantName restricts tenant names to a character set that's safe to
+// embed in etcd paths (and rejects path elements like ".." outright)
+var validTenantName = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// configuredTenants returns the tenant names in the pachd deployment's
+// AUTH_TENANTS setting (none, on most clusters)
+func configuredTenants(env *serviceenv.ServiceEnv) []string {
+	if env == nil || env.Configuration == nil || env.PachdSpecificConfiguration == nil {
+		return nil
+	}
+	var tenants []string
+	for _, t := range strings.Split(env.AuthTenants, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			tenants = append(tenants, t)
+		}
+	}
+	return tenants
+}
+
+// tenantFromContext returns the tenant name in the request context's
+// auth-tenant metadata key, or "" if the request doesn't name a tenant and
+// should be handled by the default domain
+func tenantFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	tenants := md.Get(authclient.ContextTenantKey)
+	if len(tenants) == 0 {
+		return "", nil
+	}
+	if len(tenants) > 1 {
+		return "", fmt.Errorf("multiple auth tenants in request metadata")
+	}
+	if !validTenantName.MatchString(tenants[0]) {
+		return "", fmt.Errorf("invalid auth tenant name %q", tenants[0])
+	}
+	return tenants[0], nil
+}
+
+// tenantRouter implements the auth APIServer interface on clusters with
+// multiple auth tenants by forwarding each request to the apiServer of the
+// tenant it names (or to the default domain's apiServer, if it names none).
+// Each tenant's apiServer is an ordinary auth server rooted at a per-tenant
+// etcd prefix, so tenants have fully separate admins, ACLs, tokens, and
+// configs. The set of tenants is fixed at startup, so no locking is needed
+// here. SAML services are only started for the default domain (every tenant's
+// server is constructed with public=false)
+type tenantRouter struct {
+	defaultServer *apiServer
+	tenants       map[string]*apiServer
+}
+
+// newTenantRouter returns a tenantRouter serving 'defaultServer' plus one
+// newly-constructed apiServer per name in 'tenants'
+func newTenantRouter(
+	env *serviceenv.ServiceEnv,
+	txnEnv *txnenv.TransactionEnv,
+	etcdPrefix string,
+	defaultServer *apiServer,
+	tenants []string,
+) (APIServer, error) {
+	r := &tenantRouter{
+		defaultServer: defaultServer,
+		tenants:       make(map[string]*apiServer),
+	}
+	for _, tenant := range tenants {
+		if !validTenantName.MatchString(tenant) {
+			return nil, fmt.Errorf("invalid auth tenant name %q in AUTH_TENANTS", tenant)
+		}
+		if _, ok := r.tenants[tenant]; ok {
+			return nil, fmt.Errorf("duplicate auth tenant name %q in AUTH_TENANTS", tenant)
+		}
+		s, err := newAPIServer(env, txnEnv,
+			path.Join(etcdPrefix, tenantsEtcdPrefix, tenant), false)
+		if err != nil {
+			return nil, fmt.Errorf("could not create auth server for tenant %q: %v", tenant, err)
+		}
+		r.tenants[tenant] = s
+	}
+	return r, nil
+}
+
+// serverFor returns the apiServer of the tenant named by the request context
+// 'ctx' (the default domain's, if it names none)
+func (r *tenantRouter) serverFor(ctx context.Context) (*apiServer, error) {
+	tenant, err := tenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == "" {
+		return r.defaultServer, nil
+	}
+	s, ok := r.tenants[tenant]
+	if !ok {
+		return nil, fmt.Errorf("no auth tenant %q is configured on this cluster", tenant)
+	}
+	return s, nil
+}
+
+// apiServerForRequest resolves the apiServer that will handle the request in
+// 'ctx': 'server' itself if it's a plain auth server, or the per-tenant
+// server selected by the request's auth-tenant metadata key if it's a
+// tenantRouter. A nil result with no error means 'server' wasn't returned by
+// NewAuthServer, and the caller should pass the request through
+func apiServerForRequest(ctx context.Context, server APIServer) (*apiServer, error) {
+	switch s := server.(type) {
+	case *apiServer:
+		return s, nil
+	case *tenantRouter:
+		return s.serverFor(ctx)
+	}
+	return nil, nil
+}
+
+// Close closes the default server and every tenant server.
+func (r *tenantRouter) Close() error {
+	err := r.defaultServer.Close()
+	for _, s := range r.tenants {
+		if closeErr := s.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+func (r *tenantRouter) Activate(ctx context.Context, req *authclient.ActivateRequest) (*authclient.ActivateResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Activate(ctx, req)
+}
+
+func (r *tenantRouter) GetActivationStatus(ctx context.Context, req *authclient.GetActivationStatusRequest) (*authclient.GetActivationStatusResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetActivationStatus(ctx, req)
+}
+
+func (r *tenantRouter) AuthHealth(ctx context.Context, req *authclient.AuthHealthRequest) (*authclient.AuthHealthResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.AuthHealth(ctx, req)
+}
+
+func (r *tenantRouter) WatchAuthState(req *authclient.WatchAuthStateRequest, stream authclient.API_WatchAuthStateServer) error {
+	s, err := r.serverFor(stream.Context())
+	if err != nil {
+		return err
+	}
+	return s.WatchAuthState(req, stream)
+}
+
+func (r *tenantRouter) Deactivate(ctx context.Context, req *authclient.DeactivateRequest) (*authclient.DeactivateResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Deactivate(ctx, req)
+}
+
+func (r *tenantRouter) GetConfiguration(ctx context.Context, req *authclient.GetConfigurationRequest) (*authclient.GetConfigurationResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetConfiguration(ctx, req)
+}
+
+func (r *tenantRouter) SetConfiguration(ctx context.Context, req *authclient.SetConfigurationRequest) (*authclient.SetConfigurationResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.SetConfiguration(ctx, req)
+}
+
+func (r *tenantRouter) GetAdmins(ctx context.Context, req *authclient.GetAdminsRequest) (*authclient.GetAdminsResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetAdmins(ctx, req)
+}
+
+func (r *tenantRouter) ModifyAdmins(ctx context.Context, req *authclient.ModifyAdminsRequest) (*authclient.ModifyAdminsResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ModifyAdmins(ctx, req)
+}
+
+func (r *tenantRouter) Authenticate(ctx context.Context, req *authclient.AuthenticateRequest) (*authclient.AuthenticateResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Authenticate(ctx, req)
+}
+
+func (r *tenantRouter) Authorize(ctx context.Context, req *authclient.AuthorizeRequest) (*authclient.AuthorizeResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Authorize(ctx, req)
+}
+
+func (r *tenantRouter) WhoAmI(ctx context.Context, req *authclient.WhoAmIRequest) (*authclient.WhoAmIResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.WhoAmI(ctx, req)
+}
+
+func (r *tenantRouter) GetScope(ctx context.Context, req *authclient.GetScopeRequest) (*authclient.GetScopeResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetScope(ctx, req)
+}
+
+func (r *tenantRouter) SetScope(ctx context.Context, req *authclient.SetScopeRequest) (*authclient.SetScopeResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.SetScope(ctx, req)
+}
+
+func (r *tenantRouter) GetACL(ctx context.Context, req *authclient.GetACLRequest) (*authclient.GetACLResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetACL(ctx, req)
+}
+
+func (r *tenantRouter) SetACL(ctx context.Context, req *authclient.SetACLRequest) (*authclient.SetACLResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.SetACL(ctx, req)
+}
+
+func (r *tenantRouter) GetACLHistory(ctx context.Context, req *authclient.GetACLHistoryRequest) (*authclient.GetACLHistoryResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetACLHistory(ctx, req)
+}
+
+func (r *tenantRouter) RestoreACL(ctx context.Context, req *authclient.RestoreACLRequest) (*authclient.RestoreACLResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.RestoreACL(ctx, req)
+}
+
+func (r *tenantRouter) ExplainScope(ctx context.Context, req *authclient.ExplainScopeRequest) (*authclient.ExplainScopeResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ExplainScope(ctx, req)
+}
+
+func (r *tenantRouter) WhoCanAccess(ctx context.Context, req *authclient.WhoCanAccessRequest) (*authclient.WhoCanAccessResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.WhoCanAccess(ctx, req)
+}
+
+func (r *tenantRouter) GetAuthToken(ctx context.Context, req *authclient.GetAuthTokenRequest) (*authclient.GetAuthTokenResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetAuthToken(ctx, req)
+}
+
+func (r *tenantRouter) ExtendAuthToken(ctx context.Context, req *authclient.ExtendAuthTokenRequest) (*authclient.ExtendAuthTokenResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ExtendAuthToken(ctx, req)
+}
+
+func (r *tenantRouter) RevokeAuthToken(ctx context.Context, req *authclient.RevokeAuthTokenRequest) (*authclient.RevokeAuthTokenResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.RevokeAuthToken(ctx, req)
+}
+
+func (r *tenantRouter) ListTokens(ctx context.Context, req *authclient.ListTokensRequest) (*authclient.ListTokensResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ListTokens(ctx, req)
+}
+
+func (r *tenantRouter) DescribeToken(ctx context.Context, req *authclient.DescribeTokenRequest) (*authclient.DescribeTokenResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.DescribeToken(ctx, req)
+}
+
+func (r *tenantRouter) RevokeTokensForUser(ctx context.Context, req *authclient.RevokeTokensForUserRequest) (*authclient.RevokeTokensForUserResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.RevokeTokensForUser(ctx, req)
+}
+
+func (r *tenantRouter) CreateGroup(ctx context.Context, req *authclient.CreateGroupRequest) (*authclient.CreateGroupResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.CreateGroup(ctx, req)
+}
+
+func (r *tenantRouter) ListGroups(ctx context.Context, req *authclient.ListGroupsRequest) (*authclient.ListGroupsResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ListGroups(ctx, req)
+}
+
+func (r *tenantRouter) UpdateGroup(ctx context.Context, req *authclient.UpdateGroupRequest) (*authclient.UpdateGroupResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.UpdateGroup(ctx, req)
+}
+
+func (r *tenantRouter) DeleteGroup(ctx context.Context, req *authclient.DeleteGroupRequest) (*authclient.DeleteGroupResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.DeleteGroup(ctx, req)
+}
+
+func (r *tenantRouter) SetGroupsForUser(ctx context.Context, req *authclient.SetGroupsForUserRequest) (*authclient.SetGroupsForUserResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.SetGroupsForUser(ctx, req)
+}
+
+func (r *tenantRouter) ModifyMembers(ctx context.Context, req *authclient.ModifyMembersRequest) (*authclient.ModifyMembersResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ModifyMembers(ctx, req)
+}
+
+func (r *tenantRouter) SetGroupMembership(req *authclient.SetGroupMembershipRequest, stream authclient.API_SetGroupMembershipServer) error {
+	s, err := r.serverFor(stream.Context())
+	if err != nil {
+		return err
+	}
+	return s.SetGroupMembership(req, stream)
+}
+
+func (r *tenantRouter) GetGroups(ctx context.Context, req *authclient.GetGroupsRequest) (*authclient.GetGroupsResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetGroups(ctx, req)
+}
+
+func (r *tenantRouter) GetUsers(ctx context.Context, req *authclient.GetUsersRequest) (*authclient.GetUsersResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetUsers(ctx, req)
+}
+
+func (r *tenantRouter) Fsck(ctx context.Context, req *authclient.FsckRequest) (*authclient.FsckResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Fsck(ctx, req)
+}
+
+func (r *tenantRouter) ExtractAuthState(req *authclient.ExtractAuthStateRequest, stream authclient.API_ExtractAuthStateServer) error {
+	s, err := r.serverFor(stream.Context())
+	if err != nil {
+		return err
+	}
+	return s.ExtractAuthState(req, stream)
+}
+
+func (r *tenantRouter) RestoreAuthState(stream authclient.API_RestoreAuthStateServer) error {
+	s, err := r.serverFor(stream.Context())
+	if err != nil {
+		return err
+	}
+	return s.RestoreAuthState(stream)
+}
+
+func (r *tenantRouter) GetOneTimePassword(ctx context.Context, req *authclient.GetOneTimePasswordRequest) (*authclient.GetOneTimePasswordResponse, error) {
+	s, err := r.serverFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetOneTimePassword(ctx, req)
+}
+
+func (r *tenantRouter) AuthorizeInTransaction(txnCtx *txnenv.TransactionContext, req *authclient.AuthorizeRequest) (*authclient.AuthorizeResponse, error) {
+	s, err := r.serverFor(txnCtx.ClientContext)
+	if err != nil {
+		return nil, err
+	}
+	return s.AuthorizeInTransaction(txnCtx, req)
+}
+
+func (r *tenantRouter) AuthorizeBatchInTransaction(txnCtx *txnenv.TransactionContext, reqs []*authclient.AuthorizeRequest) ([]*authclient.AuthorizeResponse, error) {
+	s, err := r.serverFor(txnCtx.ClientContext)
+	if err != nil {
+		return nil, err
+	}
+	return s.AuthorizeBatchInTransaction(txnCtx, reqs)
+}
+
+func (r *tenantRouter) GetScopeInTransaction(txnCtx *txnenv.TransactionContext, req *authclient.GetScopeRequest) (*authclient.GetScopeResponse, error) {
+	s, err := r.serverFor(txnCtx.ClientContext)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetScopeInTransaction(txnCtx, req)
+}
+
+func (r *tenantRouter) SetScopeInTransaction(txnCtx *txnenv.TransactionContext, req *authclient.SetScopeRequest) (*authclient.SetScopeResponse, error) {
+	s, err := r.serverFor(txnCtx.ClientContext)
+	if err != nil {
+		return nil, err
+	}
+	return s.SetScopeInTransaction(txnCtx, req)
+}
+
+func (r *tenantRouter) GetACLInTransaction(txnCtx *txnenv.TransactionContext, req *authclient.GetACLRequest) (*authclient.GetACLResponse, error) {
+	s, err := r.serverFor(txnCtx.ClientContext)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetACLInTransaction(txnCtx, req)
+}
+
+func (r *tenantRouter) SetACLInTransaction(txnCtx *txnenv.TransactionContext, req *authclient.SetACLRequest) (*authclient.SetACLResponse, error) {
+	s, err := r.serverFor(txnCtx.ClientContext)
+	if err != nil {
+		return nil, err
+	}
+	return s.SetACLInTransaction(txnCtx, req)
+}
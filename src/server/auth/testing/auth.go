@@ -18,6 +18,21 @@ func (a *InactiveAPIServer) Activate(context.Context, *auth.ActivateRequest) (*a
 	return nil, auth.ErrNotActivated
 }
 
+// GetActivationStatus implements the GetActivationStatus RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) GetActivationStatus(context.Context, *auth.GetActivationStatusRequest) (*auth.GetActivationStatusResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// AuthHealth implements the AuthHealth RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) AuthHealth(context.Context, *auth.AuthHealthRequest) (*auth.AuthHealthResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// WatchAuthState implements the WatchAuthState RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) WatchAuthState(*auth.WatchAuthStateRequest, auth.API_WatchAuthStateServer) error {
+	return auth.ErrNotActivated
+}
+
 // Deactivate implements the Deactivate RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) Deactivate(context.Context, *auth.DeactivateRequest) (*auth.DeactivateResponse, error) {
 	return nil, auth.ErrNotActivated
@@ -49,6 +64,12 @@ func (a *InactiveAPIServer) AuthorizeInTransaction(*txnenv.TransactionContext, *
 	return nil, auth.ErrNotActivated
 }
 
+// AuthorizeBatchInTransaction is the same as AuthorizeInTransaction but for
+// many requests at once.  It also returns a NotActivatedError.
+func (a *InactiveAPIServer) AuthorizeBatchInTransaction(*txnenv.TransactionContext, []*auth.AuthorizeRequest) ([]*auth.AuthorizeResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
 // WhoAmI implements the WhoAmI RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) WhoAmI(context.Context, *auth.WhoAmIRequest) (*auth.WhoAmIResponse, error) {
 	return nil, auth.ErrNotActivated
@@ -98,6 +119,26 @@ func (a *InactiveAPIServer) SetACLInTransaction(*txnenv.TransactionContext, *aut
 	return nil, auth.ErrNotActivated
 }
 
+// GetACLHistory implements the GetACLHistory RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) GetACLHistory(context.Context, *auth.GetACLHistoryRequest) (*auth.GetACLHistoryResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// RestoreACL implements the RestoreACL RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) RestoreACL(context.Context, *auth.RestoreACLRequest) (*auth.RestoreACLResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// ExplainScope implements the ExplainScope RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ExplainScope(context.Context, *auth.ExplainScopeRequest) (*auth.ExplainScopeResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// WhoCanAccess implements the WhoCanAccess RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) WhoCanAccess(context.Context, *auth.WhoCanAccessRequest) (*auth.WhoCanAccessResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
 // GetAuthToken implements the GetAuthToken RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) GetAuthToken(context.Context, *auth.GetAuthTokenRequest) (*auth.GetAuthTokenResponse, error) {
 	return nil, auth.ErrNotActivated
@@ -113,6 +154,41 @@ func (a *InactiveAPIServer) RevokeAuthToken(context.Context, *auth.RevokeAuthTok
 	return nil, auth.ErrNotActivated
 }
 
+// ListTokens implements the ListTokens RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ListTokens(context.Context, *auth.ListTokensRequest) (*auth.ListTokensResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// DescribeToken implements the DescribeToken RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) DescribeToken(context.Context, *auth.DescribeTokenRequest) (*auth.DescribeTokenResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// RevokeTokensForUser implements the RevokeTokensForUser RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) RevokeTokensForUser(context.Context, *auth.RevokeTokensForUserRequest) (*auth.RevokeTokensForUserResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// CreateGroup implements the CreateGroup RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) CreateGroup(context.Context, *auth.CreateGroupRequest) (*auth.CreateGroupResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// ListGroups implements the ListGroups RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ListGroups(context.Context, *auth.ListGroupsRequest) (*auth.ListGroupsResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// UpdateGroup implements the UpdateGroup RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) UpdateGroup(context.Context, *auth.UpdateGroupRequest) (*auth.UpdateGroupResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// DeleteGroup implements the DeleteGroup RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) DeleteGroup(context.Context, *auth.DeleteGroupRequest) (*auth.DeleteGroupResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
 // SetGroupsForUser implements the SetGroupsForUser RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) SetGroupsForUser(context.Context, *auth.SetGroupsForUserRequest) (*auth.SetGroupsForUserResponse, error) {
 	return nil, auth.ErrNotActivated
@@ -123,6 +199,11 @@ func (a *InactiveAPIServer) ModifyMembers(context.Context, *auth.ModifyMembersRe
 	return nil, auth.ErrNotActivated
 }
 
+// SetGroupMembership implements the SetGroupMembership RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) SetGroupMembership(*auth.SetGroupMembershipRequest, auth.API_SetGroupMembershipServer) error {
+	return auth.ErrNotActivated
+}
+
 // GetGroups implements the GetGroups RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) GetGroups(context.Context, *auth.GetGroupsRequest) (*auth.GetGroupsResponse, error) {
 	return nil, auth.ErrNotActivated
@@ -133,6 +214,21 @@ func (a *InactiveAPIServer) GetUsers(context.Context, *auth.GetUsersRequest) (*a
 	return nil, auth.ErrNotActivated
 }
 
+// ExtractAuthState implements the ExtractAuthState RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ExtractAuthState(*auth.ExtractAuthStateRequest, auth.API_ExtractAuthStateServer) error {
+	return auth.ErrNotActivated
+}
+
+// RestoreAuthState implements the RestoreAuthState RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) RestoreAuthState(auth.API_RestoreAuthStateServer) error {
+	return auth.ErrNotActivated
+}
+
+// Fsck implements the Fsck RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) Fsck(context.Context, *auth.FsckRequest) (*auth.FsckResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
 // SetConfiguration implements the SetConfiguration RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) SetConfiguration(context.Context, *auth.SetConfigurationRequest) (*auth.SetConfigurationResponse, error) {
 	return nil, auth.ErrNotActivated
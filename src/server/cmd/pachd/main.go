@@ -143,10 +143,13 @@ func doSidecarMode(config interface{}) (retErr error) {
 	// The sidecar only needs to serve traffic on the peer port, as it only serves
 	// traffic from the user container (the worker binary and occasionally user
 	// pipelines)
+	authInterceptor := authserver.NewAuthInterceptor()
 	return grpcutil.Serve(
 		grpcutil.ServerOptions{
-			Port:       env.PeerPort,
-			MaxMsgSize: grpcutil.MaxMsgSize,
+			Port:              env.PeerPort,
+			MaxMsgSize:        grpcutil.MaxMsgSize,
+			UnaryInterceptor:  authInterceptor.UnaryInterceptor,
+			StreamInterceptor: authInterceptor.StreamInterceptor,
 			RegisterFunc: func(s *grpc.Server) error {
 				txnEnv := &txnenv.TransactionEnv{}
 				blockCacheBytes, err := units.RAMInBytes(env.BlockCacheBytes)
@@ -200,6 +203,7 @@ func doSidecarMode(config interface{}) (retErr error) {
 				if err != nil {
 					return fmt.Errorf("NewAuthServer: %v", err)
 				}
+				authInterceptor.Bind(authAPIServer)
 				authclient.RegisterAPIServer(s, authAPIServer)
 
 				transactionAPIServer, err := txnserver.NewAPIServer(
@@ -350,11 +354,19 @@ func doFullMode(config interface{}) (retErr error) {
 		return fmt.Errorf("ListenAndServe: %v", err)
 	})
 	eg.Go(func() error {
+		// networkPolicy enforces the CIDR allowlists in the auth config (if
+		// any) on the public port, and authInterceptor performs the
+		// activation/authentication checks declared in the auth server's
+		// method policies; both are bound to the auth API server below
+		networkPolicy := authserver.NewNetworkPolicyEnforcer()
+		authInterceptor := authserver.NewAuthInterceptor()
 		err := grpcutil.Serve(
 			grpcutil.ServerOptions{
 				Port:                 env.Port,
 				MaxMsgSize:           grpcutil.MaxMsgSize,
 				PublicPortTLSAllowed: true,
+				UnaryInterceptor:     grpcutil.ChainUnaryServerInterceptors(networkPolicy.UnaryInterceptor, authInterceptor.UnaryInterceptor),
+				StreamInterceptor:    grpcutil.ChainStreamServerInterceptors(networkPolicy.StreamInterceptor, authInterceptor.StreamInterceptor),
 				RegisterFunc: func(s *grpc.Server) error {
 					txnEnv := &txnenv.TransactionEnv{}
 					memoryRequestBytes, err := units.RAMInBytes(env.MemoryRequest)
@@ -412,6 +424,8 @@ func doFullMode(config interface{}) (retErr error) {
 					if err != nil {
 						return fmt.Errorf("NewAuthServer: %v", err)
 					}
+					networkPolicy.Bind(authAPIServer)
+					authInterceptor.Bind(authAPIServer)
 					authclient.RegisterAPIServer(s, authAPIServer)
 
 					transactionAPIServer, err := txnserver.NewAPIServer(
@@ -456,10 +470,16 @@ func doFullMode(config interface{}) (retErr error) {
 	// APIServer structs here so we can serve the Pachyderm API on the
 	// peer port
 	eg.Go(func() error {
+		// like the public port, the peer port enforces the auth server's
+		// method policies (but not the network policy, as peer traffic is
+		// cluster-internal)
+		authInterceptor := authserver.NewAuthInterceptor()
 		err := grpcutil.Serve(
 			grpcutil.ServerOptions{
-				Port:       env.PeerPort,
-				MaxMsgSize: grpcutil.MaxMsgSize,
+				Port:              env.PeerPort,
+				MaxMsgSize:        grpcutil.MaxMsgSize,
+				UnaryInterceptor:  authInterceptor.UnaryInterceptor,
+				StreamInterceptor: authInterceptor.StreamInterceptor,
 				RegisterFunc: func(s *grpc.Server) error {
 					txnEnv := &txnenv.TransactionEnv{}
 					cacheServer := cache_server.NewCacheServer(router, env.NumShards)
@@ -539,6 +559,7 @@ func doFullMode(config interface{}) (retErr error) {
 					if err != nil {
 						return fmt.Errorf("NewAuthServer: %v", err)
 					}
+					authInterceptor.Bind(authAPIServer)
 					authclient.RegisterAPIServer(s, authAPIServer)
 
 					transactionAPIServer, err := txnserver.NewAPIServer(
@@ -762,31 +762,74 @@ func (d *driver) listRepo(pachClient *client.APIClient, includeAuth bool) (*pfs.
 	ctx := pachClient.Ctx()
 	repos := d.repos.ReadOnly(ctx)
 	result := &pfs.ListRepoResponse{}
-	authSeemsActive := true
 	repoInfo := &pfs.RepoInfo{}
 	if err := repos.List(repoInfo, col.DefaultOptions, func(repoName string) error {
 		if repoName == ppsconsts.SpecRepo {
 			return nil
 		}
-		if includeAuth && authSeemsActive {
-			accessLevel, err := d.getAccessLevel(pachClient, repoInfo.Repo)
-			if err == nil {
-				repoInfo.AuthInfo = &pfs.RepoAuthInfo{AccessLevel: accessLevel}
-			} else if auth.IsErrNotActivated(err) {
-				authSeemsActive = false
-			} else {
-				return fmt.Errorf("error getting access level for \"%s\": %v",
-					repoName, grpcutil.ScrubGRPC(err))
-			}
-		}
 		result.RepoInfo = append(result.RepoInfo, proto.Clone(repoInfo).(*pfs.RepoInfo))
 		return nil
 	}); err != nil {
 		return nil, err
 	}
+	if includeAuth {
+		if err := d.annotateAccessLevels(pachClient, result.RepoInfo); err != nil && !auth.IsErrNotActivated(err) {
+			return nil, fmt.Errorf("error getting access levels: %v", grpcutil.ScrubGRPC(err))
+		}
+	}
 	return result, nil
 }
 
+// annotateAccessLevels fills in the AuthInfo of every RepoInfo in 'repoInfos'
+// with the caller's access level. The levels are resolved in one batch against
+// a single snapshot of the ACLs (see AuthorizeBatchInTransaction), rather than
+// with one auth call per repo. If auth isn't activated this returns
+// ErrNotActivated and leaves the infos unannotated.
+func (d *driver) annotateAccessLevels(pachClient *client.APIClient, repoInfos []*pfs.RepoInfo) error {
+	who, err := pachClient.AuthAPIClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{})
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	if who.IsAdmin {
+		for _, repoInfo := range repoInfos {
+			repoInfo.AuthInfo = &pfs.RepoAuthInfo{AccessLevel: auth.Scope_OWNER}
+		}
+		return nil
+	}
+	// One AuthorizeRequest per (repo, scope) pair; a repo's access level is
+	// the highest scope for which the batch authorizes the caller ('scopes'
+	// must stay in ascending order for the loop below)
+	scopes := []auth.Scope{auth.Scope_READER, auth.Scope_WRITER, auth.Scope_OWNER}
+	reqs := make([]*auth.AuthorizeRequest, 0, len(repoInfos)*len(scopes))
+	for _, repoInfo := range repoInfos {
+		for _, scope := range scopes {
+			reqs = append(reqs, &auth.AuthorizeRequest{Repo: repoInfo.Repo.Name, Scope: scope})
+		}
+	}
+	var resps []*auth.AuthorizeResponse
+	if err := d.txnEnv.WithReadContext(pachClient.Ctx(), func(txnCtx *txnenv.TransactionContext) error {
+		var err error
+		resps, err = txnCtx.Auth().AuthorizeBatchInTransaction(txnCtx, reqs)
+		return err
+	}); err != nil {
+		return err
+	}
+	if len(resps) != len(reqs) {
+		return fmt.Errorf("wrong number of responses from AuthorizeBatch: %d (expected %d)",
+			len(resps), len(reqs))
+	}
+	for i, repoInfo := range repoInfos {
+		accessLevel := auth.Scope_NONE
+		for j, scope := range scopes {
+			if resps[i*len(scopes)+j].Authorized {
+				accessLevel = scope
+			}
+		}
+		repoInfo.AuthInfo = &pfs.RepoAuthInfo{AccessLevel: accessLevel}
+	}
+	return nil
+}
+
 func (d *driver) deleteRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, force bool) error {
 	// TODO(msteffen): Fix d.deleteAll() so that it doesn't need to delete and
 	// recreate the PPS spec repo, then uncomment this block to prevent users from
@@ -965,16 +1008,16 @@ func (d *driver) buildCommit(ctx context.Context, ID string, parent *pfs.Commit,
 
 // make commit makes a new commit in 'branch', with the parent 'parent' and the
 // direct provenance 'provenance'. Note that
-// - 'parent' must not be nil, but the only required field is 'parent.Repo'.
-// - 'parent.ID' may be set to "", in which case the parent commit is inferred
-//   from 'parent.Repo' and 'branch'.
-// - If both 'parent.ID' and 'branch' are set, 'parent.ID' determines the parent
-//   commit, but 'branch' is still moved to point at the new commit
-//   to the new commit
-// - If neither 'parent.ID' nor 'branch' are set, the new commit will have no
-//   parent
-// - If only 'parent.ID' is set, and it contains a branch, then the new commit's
-//   parent will be the HEAD of that branch, but the branch will not be moved
+//   - 'parent' must not be nil, but the only required field is 'parent.Repo'.
+//   - 'parent.ID' may be set to "", in which case the parent commit is inferred
+//     from 'parent.Repo' and 'branch'.
+//   - If both 'parent.ID' and 'branch' are set, 'parent.ID' determines the parent
+//     commit, but 'branch' is still moved to point at the new commit
+//     to the new commit
+//   - If neither 'parent.ID' nor 'branch' are set, the new commit will have no
+//     parent
+//   - If only 'parent.ID' is set, and it contains a branch, then the new commit's
+//     parent will be the HEAD of that branch, but the branch will not be moved
 func (d *driver) makeCommit(
 	txnCtx *txnenv.TransactionContext,
 	ID string,
@@ -1339,9 +1382,9 @@ func (d *driver) finishOutputCommit(txnCtx *txnenv.TransactionContext, commit *p
 }
 
 // writeFinishedCommit writes these changes to etcd:
-// 1) it closes the input commit (i.e., it writes any changes made to it and
-//    removes it from the open commits)
-// 2) if the commit is the new HEAD of master, it updates the repo size
+//  1. it closes the input commit (i.e., it writes any changes made to it and
+//     removes it from the open commits)
+//  2. if the commit is the new HEAD of master, it updates the repo size
 func (d *driver) writeFinishedCommit(stm col.STM, commit *pfs.Commit, commitInfo *pfs.CommitInfo) error {
 	commits := d.commits(commit.Repo.Name).ReadWrite(stm)
 	if err := commits.Put(commit.ID, commitInfo); err != nil {
@@ -1378,8 +1421,10 @@ func (d *driver) writeFinishedCommit(stm col.STM, commit *pfs.Commit, commitInfo
 // propagateCommits selectively starts commits in or downstream of 'branches' in
 // order to restore the invariant that branch provenance matches HEAD commit
 // provenance:
-//   B.Head is provenant on A.Head <=>
-//   branch B is provenant on branch A and A.Head != nil
+//
+//	B.Head is provenant on A.Head <=>
+//	branch B is provenant on branch A and A.Head != nil
+//
 // The implementation assumes that the invariant already holds for all branches
 // upstream of 'branches', but not necessarily for each 'branch' itself. Despite
 // the name, 'branches' do not need a HEAD commit to propagate, though one may be
@@ -2281,7 +2326,8 @@ func (d *driver) resolveCommitProvenance(stm col.STM, userCommitProvenance *pfs.
 // createBranch creates a new branch or updates an existing branch (must be one
 // or the other). Most importantly, it sets 'branch.DirectProvenance' to
 // 'provenance' and then for all (downstream) branches, restores the invariant:
-//   ∀ b . b.Provenance = ∪ b'.Provenance (where b' ∈ b.DirectProvenance)
+//
+//	∀ b . b.Provenance = ∪ b'.Provenance (where b' ∈ b.DirectProvenance)
 //
 // This invariant is assumed to hold for all branches upstream of 'branch', but not
 // for 'branch' itself once 'b.Provenance' has been set.
@@ -3181,26 +3227,18 @@ func (d *driver) getFile(pachClient *client.APIClient, file *pfs.File, offset in
 				prevDir = parentPath
 				if node.FileNode.HasHeaderFooter {
 					// if any child of 'node's parent directory has HasHeaderFooter set,
-					// then they all should
-					parentNode, err := tree.Get(parentPath)
+					// then they all should. The shared data may be attached above the
+					// immediate parent for nested split formats
+					_, shared, err := tree.SharedAncestor(p)
 					if err != nil {
 						return fmt.Errorf("file %q has a header, but could not "+
-							"retrieve parent node at %q to get header content: %v", p,
-							parentPath, err)
-					}
-					if parentNode.DirNode == nil {
-						return fmt.Errorf("parent of %q is not a directory—this is "+
-							"likely an internal error", p)
+							"retrieve shared header content: %v", p, err)
 					}
-					if parentNode.DirNode.Shared == nil {
-						return fmt.Errorf("file %q has a shared header or footer, "+
-							"but parent directory does not permit shared data", p)
+					if shared.Header != nil {
+						objects = append(objects, shared.Header)
 					}
-					if parentNode.DirNode.Shared.Header != nil {
-						objects = append(objects, parentNode.DirNode.Shared.Header)
-					}
-					if parentNode.DirNode.Shared.Footer != nil {
-						footer = parentNode.DirNode.Shared.Footer
+					if shared.Footer != nil {
+						footer = shared.Footer
 					}
 				}
 			}
@@ -3329,25 +3367,14 @@ func nodeToFileInfoHeaderFooter(ci *pfs.CommitInfo, filePath string,
 		return nil, fmt.Errorf("input commit node uses blockrefs; cannot apply header")
 	}
 
-	// 'file' includes header from parent—construct synthetic file info that
-	// includes header in list of objects & hash
-	parentPath := path.Dir(filePath)
-	parentNode, err := tree.Get(parentPath)
+	// 'file' includes header from an ancestor directory (usually the parent,
+	// but possibly higher for nested split formats)—construct synthetic file
+	// info that includes header in list of objects & hash
+	_, s, err := tree.SharedAncestor(filePath)
 	if err != nil {
 		return nil, fmt.Errorf("file %q has a header, but could not "+
-			"retrieve parent node at %q to get header content: %v", filePath,
-			parentPath, err)
-	}
-	if parentNode.DirNode == nil {
-		return nil, fmt.Errorf("parent of %q is not a directory; this is "+
-			"likely an internal error", filePath)
+			"retrieve shared header content: %v", filePath, err)
 	}
-	if parentNode.DirNode.Shared == nil {
-		return nil, fmt.Errorf("file %q has a shared header or footer, "+
-			"but parent directory does not permit shared data", filePath)
-	}
-
-	s := parentNode.DirNode.Shared
 	var newObjects []*pfs.Object
 	if s.Header != nil {
 		// cap := len+1 => newObjects is right whether or not we append() a footer
@@ -122,7 +122,7 @@ func (s *objBlockAPIServer) watchGC(etcdAddress string) {
 			return fmt.Errorf("error instantiating etcd client: %v", err)
 		}
 
-		watcher, err := watch.NewWatcher(context.Background(), etcdClient, "", client.GCGenerationKey, nil)
+		watcher, err := watch.NewWatcher(context.Background(), etcdClient, "", client.GCGenerationKey, nil, nil)
 		if err != nil {
 			return fmt.Errorf("error instantiating watch stream from generation number: %v", err)
 		}
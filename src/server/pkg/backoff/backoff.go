@@ -10,7 +10,10 @@
 // See Examples section below for usage examples.
 package backoff
 
-import "time"
+import (
+	"math/rand"
+	"time"
+)
 
 // BackOff is a backoff policy for retrying an operation.
 type BackOff interface {
@@ -108,3 +111,61 @@ func (b *ConstantBackOff) For(maxElapsed time.Duration) *ConstantBackOff {
 	b.MaxElapsedTime = maxElapsed
 	return b
 }
+
+// maxRetriesBackOff wraps another policy with a retry budget (see
+// WithMaxRetries).
+type maxRetriesBackOff struct {
+	delegate BackOff
+	max      uint64
+	numTries uint64
+}
+
+// WithMaxRetries wraps 'b' so that NextBackOff returns Stop after 'max'
+// retries, giving the operation a fixed retry budget regardless of the
+// underlying policy. Reset restores the budget.
+func WithMaxRetries(b BackOff, max uint64) BackOff {
+	return &maxRetriesBackOff{delegate: b, max: max}
+}
+
+func (b *maxRetriesBackOff) NextBackOff() time.Duration {
+	if b.numTries >= b.max {
+		return Stop
+	}
+	b.numTries++
+	return b.delegate.NextBackOff()
+}
+
+func (b *maxRetriesBackOff) Reset() {
+	b.numTries = 0
+	b.delegate.Reset()
+}
+
+// jitterBackOff wraps another policy with randomized delays (see WithJitter).
+type jitterBackOff struct {
+	delegate BackOff
+	factor   float64
+}
+
+// WithJitter wraps 'b' so that each delay is scaled by a random value in
+// [1-factor, 1+factor], desynchronizing retry storms from many clients that
+// fail at the same time. Policies that already randomize (like
+// ExponentialBackOff) don't need it; it's meant for ConstantBackOff and
+// friends.
+func WithJitter(b BackOff, factor float64) BackOff {
+	return &jitterBackOff{delegate: b, factor: factor}
+}
+
+func (b *jitterBackOff) NextBackOff() time.Duration {
+	next := b.delegate.NextBackOff()
+	if next == Stop {
+		return Stop
+	}
+	delta := b.factor * float64(next)
+	min := float64(next) - delta
+	// the formula yields a random value in [min, min+2*delta)
+	return time.Duration(min + (rand.Float64() * 2 * delta))
+}
+
+func (b *jitterBackOff) Reset() {
+	b.delegate.Reset()
+}
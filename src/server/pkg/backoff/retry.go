@@ -1,6 +1,9 @@
 package backoff
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // An Operation is executing by Retry() or RetryNotify().
 // The operation will be retried using a backoff policy if it returns an error.
@@ -49,3 +52,42 @@ func RetryNotify(operation Operation, b BackOff, notify Notify) error {
 		time.Sleep(next)
 	}
 }
+
+// RetryUntilCancel is like RetryNotify, except that it stops cleanly when
+// 'ctx' is cancelled: both between attempts and during the backoff sleep.
+// This is what long-lived retry loops (e.g. the auth server's watch
+// goroutines) should use, so that server shutdown doesn't leak goroutines
+// that retry forever. It returns ctx.Err() if the context ended the retries.
+func RetryUntilCancel(ctx context.Context, operation Operation, b BackOff, notify Notify) error {
+	var err error
+	var next time.Duration
+
+	b.Reset()
+	for {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		if err = operation(); err == nil {
+			return nil
+		}
+
+		if next = b.NextBackOff(); next == Stop {
+			return err
+		}
+
+		if notify != nil {
+			if err := notify(err, next); err != nil {
+				return err
+			}
+		}
+
+		timer := time.NewTimer(next)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
@@ -1,9 +1,11 @@
 package backoff
 
 import (
+	"context"
 	"errors"
 	"log"
 	"testing"
+	"time"
 )
 
 func TestRetry(t *testing.T) {
@@ -32,3 +34,70 @@ func TestRetry(t *testing.T) {
 		t.Errorf("invalid number of retries: %d", i)
 	}
 }
+
+func TestRetryUntilCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var i = 0
+
+	// The operation always fails; the first attempt cancels the context,
+	// which must stop the retries during the following backoff sleep.
+	err := RetryUntilCancel(ctx, func() error {
+		i++
+		cancel()
+		return errors.New("error")
+	}, RetryEvery(time.Hour), nil)
+	if err != context.Canceled {
+		t.Errorf("expected context.Canceled, got: %v", err)
+	}
+	if i != 1 {
+		t.Errorf("invalid number of retries: %d", i)
+	}
+
+	// A cancelled context stops the loop before the first attempt.
+	i = 0
+	if err := RetryUntilCancel(ctx, func() error {
+		i++
+		return nil
+	}, RetryEvery(time.Hour), nil); err != context.Canceled {
+		t.Errorf("expected context.Canceled, got: %v", err)
+	}
+	if i != 0 {
+		t.Errorf("operation ran %d times under a cancelled context", i)
+	}
+}
+
+func TestWithMaxRetries(t *testing.T) {
+	var i = 0
+
+	b := WithMaxRetries(&ZeroBackOff{}, 4)
+	err := Retry(func() error {
+		i++
+		return errors.New("error")
+	}, b)
+	if err == nil {
+		t.Error("expected the budget to be exhausted with an error")
+	}
+	if i != 5 { // initial attempt + 4 retries
+		t.Errorf("invalid number of attempts: %d", i)
+	}
+
+	// Reset restores the budget
+	b.Reset()
+	if b.NextBackOff() == Stop {
+		t.Error("expected a reset budget to allow retries again")
+	}
+}
+
+func TestWithJitter(t *testing.T) {
+	base := 10 * time.Second
+	b := WithJitter(&ConstantBackOff{Interval: base}, 0.5)
+	for i := 0; i < 100; i++ {
+		next := b.NextBackOff()
+		if next < 5*time.Second || next > 15*time.Second {
+			t.Errorf("jittered delay %s outside [5s, 15s]", next)
+		}
+	}
+	if WithJitter(&StopBackOff{}, 0.5).NextBackOff() != Stop {
+		t.Error("jitter should pass Stop through unchanged")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"path"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -55,6 +56,10 @@ type collection struct {
 
 	// valCheck is a function that checks if a value is valid.
 	valCheck func(proto.Message) error
+
+	// codec, if set, transforms values on their way to/from etcd (e.g. to
+	// encrypt them at rest). See watch.Codec.
+	codec watch.Codec
 }
 
 // NewCollection creates a new collection.
@@ -78,6 +83,39 @@ func NewCollection(etcdClient *etcd.Client, prefix string, indexes []*Index, tem
 	}
 }
 
+// NewCollectionWithCodec is like NewCollection, but passes stored values
+// through 'codec' on their way to/from etcd (e.g. to encrypt them at rest). A
+// nil codec yields the same behavior as NewCollection.
+func NewCollectionWithCodec(etcdClient *etcd.Client, prefix string, indexes []*Index, template proto.Message, keyCheck func(string) error, valCheck func(proto.Message) error, codec watch.Codec) Collection {
+	c := NewCollection(etcdClient, prefix, indexes, template, keyCheck, valCheck).(*collection)
+	c.codec = codec
+	return c
+}
+
+// marshalVal marshals 'val' and passes the result through c's codec, if any
+func (c *collection) marshalVal(val proto.Message) ([]byte, error) {
+	data, err := proto.Marshal(val)
+	if err != nil {
+		return nil, err
+	}
+	if c.codec != nil {
+		return c.codec.Encode(data)
+	}
+	return data, nil
+}
+
+// unmarshalVal passes 'data' through c's codec, if any, and unmarshals the
+// result into 'val'
+func (c *collection) unmarshalVal(data []byte, val proto.Message) error {
+	if c.codec != nil {
+		var err error
+		if data, err = c.codec.Decode(data); err != nil {
+			return err
+		}
+	}
+	return proto.Unmarshal(data, val)
+}
+
 func (c *collection) ReadWrite(stm STM) ReadWriteCollection {
 	return &readWriteCollection{
 		collection: c,
@@ -144,6 +182,68 @@ func (c *collection) Claim(ctx context.Context, key string, val proto.Message, f
 	return f(claimCtx)
 }
 
+// maxTxnOps is the most operations PutManyChunked packs into one etcd txn
+// (etcd's own default txn-size limit is 128 ops; secondary-index writes count
+// against it too, so stay well below).
+const maxTxnOps = 64
+
+// PutManyChunked writes 'vals' in chunks of at most maxTxnOps keys per etcd
+// txn. Each chunk commits atomically, but the write as a whole is not
+// transactional; it's meant for bulk imports (e.g. ModifyMembers) where N
+// separate round trips would dominate the cost.
+func (c *collection) PutManyChunked(ctx context.Context, vals map[string]proto.Message) error {
+	keys := make([]string, 0, len(vals))
+	for key := range vals {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for len(keys) > 0 {
+		chunk := keys
+		if len(chunk) > maxTxnOps {
+			chunk = chunk[:maxTxnOps]
+		}
+		if _, err := NewSTM(ctx, c.etcdClient, func(stm STM) error {
+			rw := c.ReadWrite(stm)
+			for _, key := range chunk {
+				if err := rw.Put(key, vals[key]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		keys = keys[len(chunk):]
+	}
+	return nil
+}
+
+// PutIfRev writes 'val' under 'key' only if the key's mod revision still
+// equals 'rev' (as previously returned by GetRev); a 'rev' of 0 means the key
+// must not exist. If the key was modified or deleted in the meantime it
+// returns ErrModified and writes nothing, so cross-service read-modify-write
+// flows can retry from a fresh read instead of clobbering concurrent writes.
+func (c *collection) PutIfRev(ctx context.Context, key string, val proto.Message, rev int64) error {
+	_, err := NewSTM(ctx, c.etcdClient, func(stm STM) error {
+		readWriteC := c.ReadWrite(stm)
+		// Read through the STM so the key lands in the txn's read set: if it
+		// changes before commit, the STM retries and the revision is
+		// re-checked
+		if err := readWriteC.Get(key, proto.Clone(c.template)); err != nil {
+			if !IsErrNotFound(err) {
+				return err
+			}
+			if rev != 0 {
+				return ErrModified{c.prefix, key}
+			}
+		} else if stm.Rev(c.Path(key)) != rev {
+			return ErrModified{c.prefix, key}
+		}
+		return readWriteC.Put(key, val)
+	})
+	return err
+}
+
 // Path returns the full path of a key in the etcd namespace
 func (c *collection) Path(key string) string {
 	return path.Join(c.prefix, key)
@@ -211,7 +311,7 @@ func (c *readWriteCollection) Get(key string, val proto.Message) (retErr error)
 		return err
 	}
 	c.stm.SetSafePutCheck(c.Path(key), reflect.ValueOf(val).Pointer())
-	return proto.Unmarshal([]byte(valStr), val)
+	return c.unmarshalVal([]byte(valStr), val)
 }
 
 func cloneProtoMsg(original proto.Message) proto.Message {
@@ -225,6 +325,9 @@ func cloneProtoMsg(original proto.Message) proto.Message {
 // Giving a value, an index, and the key of the item, return the path
 // under which the new index item should be stored.
 func (c *readWriteCollection) getIndexPath(val interface{}, index *Index, key string) string {
+	if index.Extract != nil {
+		return c.indexPath(index, index.Extract(val), key)
+	}
 	reflVal := reflect.ValueOf(val)
 	field := reflect.Indirect(reflVal).FieldByName(index.Field).Interface()
 	return c.indexPath(index, field, key)
@@ -234,7 +337,12 @@ func (c *readWriteCollection) getIndexPath(val interface{}, index *Index, key st
 // paths under which the multi-index items should be stored.
 func (c *readWriteCollection) getMultiIndexPaths(val interface{}, index *Index, key string) []string {
 	var indexPaths []string
-	field := reflect.Indirect(reflect.ValueOf(val)).FieldByName(index.Field)
+	var field reflect.Value
+	if index.Extract != nil {
+		field = reflect.ValueOf(index.Extract(val))
+	} else {
+		field = reflect.Indirect(reflect.ValueOf(val)).FieldByName(index.Field)
+	}
 	for i := 0; i < field.Len(); i++ {
 		indexPaths = append(indexPaths, c.indexPath(index, field.Index(i).Interface(), key))
 	}
@@ -254,6 +362,24 @@ func (c *readWriteCollection) TTL(key string) (int64, error) {
 }
 
 func (c *readWriteCollection) PutTTL(key string, val proto.Message, ttl int64) error {
+	return c.putWith(key, val, func(key, val string, ptr uintptr) error {
+		return c.stm.Put(key, val, ttl, ptr)
+	})
+}
+
+// PutLease is like Put, but attaches 'key' (and its index entries) to the
+// shared lease 'l', so the key lives until the lease expires or is revoked.
+// Unlike PutTTL, no new lease is granted: many keys across many txns can
+// share one lease and be renewed together with l.KeepAlive.
+func (c *readWriteCollection) PutLease(key string, val proto.Message, l *Lease) error {
+	return c.putWith(key, val, func(key, val string, ptr uintptr) error {
+		return c.stm.PutLease(key, val, l.ID(), l.TTL(), ptr)
+	})
+}
+
+// putWith implements PutTTL and PutLease: it runs the key/value checks and
+// maintains secondary indexes, using 'put' to stage the writes in the STM.
+func (c *readWriteCollection) putWith(key string, val proto.Message, put func(key, val string, ptr uintptr) error) error {
 	if err := watch.CheckType(c.template, val); err != nil {
 		return err
 	}
@@ -287,7 +413,7 @@ func (c *readWriteCollection) PutTTL(key string, val proto.Message, ttl int64) e
 					// we might trigger an unnecessary event if someone is
 					// watching the index
 					if _, err := c.stm.Get(indexPath); err != nil && IsErrNotFound(err) {
-						if err := c.stm.Put(indexPath, key, ttl, 0); err != nil {
+						if err := put(indexPath, key, 0); err != nil {
 							return err
 						}
 					}
@@ -319,18 +445,54 @@ func (c *readWriteCollection) PutTTL(key string, val proto.Message, ttl int64) e
 				// we might trigger an unnecessary event if someone is
 				// watching the index
 				if _, err := c.stm.Get(indexPath); err != nil && IsErrNotFound(err) {
-					if err := c.stm.Put(indexPath, key, ttl, 0); err != nil {
+					if err := put(indexPath, key, 0); err != nil {
 						return err
 					}
 				}
 			}
 		}
 	}
-	bytes, err := proto.Marshal(val)
+	bytes, err := c.marshalVal(val)
 	if err != nil {
 		return err
 	}
-	return c.stm.Put(c.Path(key), string(bytes), ttl, ptr)
+	return put(c.Path(key), string(bytes), ptr)
+}
+
+// GetMany gets several keys in one pass, calling f with each key and its
+// value. The reads are all staged in the collection's STM, so they commit (or
+// retry) together. A clone of the collection's template is made per key, so
+// callers may retain the values f receives.
+func (c *readWriteCollection) GetMany(keys []string, f func(key string, val proto.Message) error) error {
+	for _, key := range keys {
+		val := proto.Clone(c.template)
+		if err := c.Get(key, val); err != nil {
+			return err
+		}
+		if err := f(key, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PutMany puts several key/value pairs in one pass, staged in the
+// collection's STM so they commit atomically. Keys are written in sorted
+// order, keeping the resulting txn deterministic. Note that everything
+// written in one STM commits as a single etcd txn, which etcd bounds in size;
+// for bulk writes larger than one txn, see PutManyChunked.
+func (c *readWriteCollection) PutMany(vals map[string]proto.Message) error {
+	keys := make([]string, 0, len(vals))
+	for key := range vals {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := c.Put(key, vals[key]); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Update reads the current value associated with 'key', calls 'f' to update
@@ -517,7 +679,26 @@ func (c *readonlyCollection) Get(key string, val proto.Message) error {
 		return ErrNotFound{c.prefix, key}
 	}
 
-	return proto.Unmarshal(resp.Kvs[0].Value, val)
+	return c.unmarshalVal(resp.Kvs[0].Value, val)
+}
+
+// GetRev is like Get, but also returns the etcd mod revision of the key. The
+// revision can be passed to Collection.PutIfRev as an optimistic concurrency
+// token, for read-modify-write flows that can't run inside a single STM.
+func (c *readonlyCollection) GetRev(key string, val proto.Message) (int64, error) {
+	if err := watch.CheckType(c.template, val); err != nil {
+		return 0, err
+	}
+	resp, err := c.get(c.Path(key))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(resp.Kvs) == 0 {
+		return 0, ErrNotFound{c.prefix, key}
+	}
+
+	return resp.Kvs[0].ModRevision, c.unmarshalVal(resp.Kvs[0].Value, val)
 }
 
 func (c *readonlyCollection) GetByIndex(index *Index, indexVal interface{}, val proto.Message, opts *Options, f func(key string) error) error {
@@ -547,7 +728,7 @@ func (c *readonlyCollection) GetBlock(key string, val proto.Message) error {
 	if err := watch.CheckType(c.template, val); err != nil {
 		return err
 	}
-	watcher, err := watch.NewWatcher(ctx, c.etcdClient, c.prefix, c.Path(key), c.template)
+	watcher, err := watch.NewWatcher(ctx, c.etcdClient, c.prefix, c.Path(key), c.template, c.codec)
 	if err != nil {
 		return err
 	}
@@ -593,7 +774,7 @@ func (c *readonlyCollection) ListPrefix(prefix string, val proto.Message, opts *
 		queryPrefix = filepath.Join(c.prefix, prefix)
 	}
 	return c.list(queryPrefix, &c.limit, opts, func(kv *mvccpb.KeyValue) error {
-		if err := proto.Unmarshal(kv.Value, val); err != nil {
+		if err := c.unmarshalVal(kv.Value, val); err != nil {
 			return err
 		}
 		return f(strings.TrimPrefix(string(kv.Key), queryPrefix))
@@ -611,13 +792,57 @@ func (c *readonlyCollection) List(val proto.Message, opts *Options, f func(strin
 		return err
 	}
 	return c.list(c.prefix, &c.limit, opts, func(kv *mvccpb.KeyValue) error {
-		if err := proto.Unmarshal(kv.Value, val); err != nil {
+		if err := c.unmarshalVal(kv.Value, val); err != nil {
 			return err
 		}
 		return f(strings.TrimPrefix(string(kv.Key), c.prefix))
 	})
 }
 
+// ListPaged lists up to 'limit' items in key order, resuming after
+// 'continueToken' (the token returned by the previous call; "" starts at the
+// beginning). It returns the token to pass to the next call, or "" once the
+// collection is exhausted. Unlike List, each call issues a single bounded
+// read, so callers like GetUsers and ListAuthTokens can page through a large
+// collection without holding every key in memory.
+func (c *readonlyCollection) ListPaged(val proto.Message, limit int64, continueToken string, f func(key string) error) (string, error) {
+	span, _ := tracing.AddSpanToAnyExisting(c.ctx, "/etcd.RO/ListPaged", "col", c.prefix)
+	defer tracing.FinishAnySpan(span)
+	if err := watch.CheckType(c.template, val); err != nil {
+		return "", err
+	}
+	if limit <= 0 || limit > atomic.LoadInt64(&c.limit) {
+		limit = atomic.LoadInt64(&c.limit)
+	}
+	fromKey := c.prefix
+	if continueToken != "" {
+		// resume at the first key after the token
+		fromKey = c.Path(continueToken) + "\x00"
+	}
+	resp, err := c.get(fromKey,
+		etcd.WithRange(endKeyFromPrefix(c.prefix)),
+		etcd.WithSort(etcd.SortByKey, etcd.SortAscend),
+		etcd.WithLimit(limit))
+	if err != nil {
+		return "", err
+	}
+	for _, kv := range resp.Kvs {
+		if err := c.unmarshalVal(kv.Value, val); err != nil {
+			return "", err
+		}
+		if err := f(strings.TrimPrefix(string(kv.Key), c.prefix)); err != nil {
+			if err == errutil.ErrBreak {
+				return "", nil
+			}
+			return "", err
+		}
+	}
+	if !resp.More || len(resp.Kvs) == 0 {
+		return "", nil
+	}
+	return strings.TrimPrefix(string(resp.Kvs[len(resp.Kvs)-1].Key), c.prefix), nil
+}
+
 func (c *readonlyCollection) list(prefix string, limitPtr *int64, opts *Options, f func(*mvccpb.KeyValue) error) error {
 	if opts.SelfSort {
 		return listSelfSortRevision(c, prefix, limitPtr, opts, f)
@@ -634,17 +859,91 @@ func (c *readonlyCollection) Count() (int64, error) {
 	return resp.Count, err
 }
 
+// CountPrefix returns the number of keys that begin with 'prefix' (the same
+// string-prefix match as ListPrefix), using a count-only range request so no
+// values are fetched.
+func (c *readonlyCollection) CountPrefix(prefix string) (int64, error) {
+	queryPrefix := c.prefix
+	if prefix != "" {
+		// If we always call join, we'll get rid of the trailing slash we need
+		// on the root c.prefix
+		queryPrefix = filepath.Join(c.prefix, prefix)
+	}
+	resp, err := c.get(queryPrefix, etcd.WithPrefix(), etcd.WithCountOnly())
+	if err != nil {
+		return 0, err
+	}
+	return resp.Count, err
+}
+
+// Exists reports whether 'key' is present, using a count-only range request
+// so the value (which may be large) is never fetched.
+func (c *readonlyCollection) Exists(key string) (bool, error) {
+	resp, err := c.get(c.Path(key), etcd.WithCountOnly())
+	if err != nil {
+		return false, err
+	}
+	return resp.Count > 0, nil
+}
+
 // Watch a collection, returning the current content of the collection as
 // well as any future additions.
 func (c *readonlyCollection) Watch(opts ...watch.OpOption) (watch.Watcher, error) {
-	return watch.NewWatcher(c.ctx, c.etcdClient, c.prefix, c.prefix, c.template, opts...)
+	return watch.NewWatcher(c.ctx, c.etcdClient, c.prefix, c.prefix, c.template, c.codec, opts...)
+}
+
+// WatchPrefix watches only the keys of the collection that start with
+// 'prefix', so e.g. a component interested in one repo's ACL entries doesn't
+// receive (and discard) events for every other repo. The filtering happens in
+// etcd: only the matching keys are listed and watched. Keys in the delivered
+// events are still relative to the collection, as with Watch.
+func (c *readonlyCollection) WatchPrefix(prefix string, opts ...watch.OpOption) (watch.Watcher, error) {
+	return watch.NewWatcher(c.ctx, c.etcdClient, c.prefix, path.Join(c.prefix, prefix), c.template, c.codec, opts...)
+}
+
+// WatchFiltered is like Watch, but only delivers put events whose value
+// satisfies 'f' (called with the relative key and the unmarshalled value).
+// Delete events carry no value, so they are always delivered; callers that
+// track matched keys should drop deletes for keys they never saw a put for.
+func (c *readonlyCollection) WatchFiltered(f func(key string, val proto.Message) (bool, error), opts ...watch.OpOption) (watch.Watcher, error) {
+	w, err := c.Watch(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return watch.Filter(w, func(ev *watch.Event) (bool, error) {
+		if ev.Type != watch.EventPut {
+			return true, nil
+		}
+		var key string
+		val := proto.Clone(c.template)
+		if err := ev.Unmarshal(&key, val); err != nil {
+			return false, err
+		}
+		return f(key, val)
+	}), nil
+}
+
+// WatchFrom resumes watching the collection from etcd revision 'rev'
+// (typically one past the Rev of the last event the caller applied). No
+// initial listing is done, so reconnecting after a watch error doesn't
+// re-deliver the whole collection.
+func (c *readonlyCollection) WatchFrom(rev int64, opts ...watch.OpOption) (watch.Watcher, error) {
+	return watch.NewWatcherFromRev(c.ctx, c.etcdClient, c.prefix, c.prefix, c.template, c.codec, rev, opts...)
+}
+
+// WatchBuffered is like Watch, but bounds the watcher's memory: the initial
+// listing is read from etcd in pages of 'pageSize' keys and events are
+// delivered through a channel buffered to the same size (see
+// watch.NewBufferedWatcher).
+func (c *readonlyCollection) WatchBuffered(pageSize int64, opts ...watch.OpOption) (watch.Watcher, error) {
+	return watch.NewBufferedWatcher(c.ctx, c.etcdClient, c.prefix, c.prefix, c.template, c.codec, pageSize, opts...)
 }
 
 // WatchByIndex watches items in a collection that match a particular index
 func (c *readonlyCollection) WatchByIndex(index *Index, val interface{}) (watch.Watcher, error) {
 	eventCh := make(chan *watch.Event)
 	done := make(chan struct{})
-	watcher, err := watch.NewWatcher(c.ctx, c.etcdClient, c.prefix, c.indexDir(index, val), c.template)
+	watcher, err := watch.NewWatcher(c.ctx, c.etcdClient, c.prefix, c.indexDir(index, val), c.template, c.codec)
 	if err != nil {
 		return nil, err
 	}
@@ -688,9 +987,15 @@ func (c *readonlyCollection) WatchByIndex(index *Index, val interface{}) (watch.
 					// we receive this event.
 					continue
 				}
+				value := resp.Kvs[0].Value
+				if c.codec != nil {
+					if value, err = c.codec.Decode(value); err != nil {
+						return err
+					}
+				}
 				directEv = &watch.Event{
 					Key:      []byte(path.Base(string(ev.Key))),
-					Value:    resp.Kvs[0].Value,
+					Value:    value,
 					Type:     ev.Type,
 					Template: c.template,
 				}
@@ -710,13 +1015,13 @@ func (c *readonlyCollection) WatchByIndex(index *Index, val interface{}) (watch.
 // WatchOne watches a given item.  The first value returned from the watch
 // will be the current value of the item.
 func (c *readonlyCollection) WatchOne(key string) (watch.Watcher, error) {
-	return watch.NewWatcher(c.ctx, c.etcdClient, c.prefix, c.Path(key), c.template)
+	return watch.NewWatcher(c.ctx, c.etcdClient, c.prefix, c.Path(key), c.template, c.codec)
 }
 
 // WatchOneF watches a given item and executes a callback function each time an event occurs.
 // The first value returned from the watch will be the current value of the item.
 func (c *readonlyCollection) WatchOneF(key string, f func(e *watch.Event) error) error {
-	watcher, err := watch.NewWatcher(c.ctx, c.etcdClient, c.prefix, c.Path(key), c.template)
+	watcher, err := watch.NewWatcher(c.ctx, c.etcdClient, c.prefix, c.Path(key), c.template, c.codec)
 	if err != nil {
 		return err
 	}
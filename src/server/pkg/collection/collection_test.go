@@ -18,6 +18,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 
 	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 )
 
@@ -263,6 +264,65 @@ func TestIndex(t *testing.T) {
 	}))
 }
 
+func TestExtractorIndex(t *testing.T) {
+	etcdClient := getEtcdClient()
+	uuidPrefix := uuid.NewWithoutDashes()
+
+	// Index jobs by their pipeline's name (a nested value that FieldByName
+	// can't reach), via an extractor
+	pipelineNameIndex := &Index{
+		Field: "PipelineName",
+		Extract: func(val interface{}) interface{} {
+			return val.(*pps.JobInfo).Pipeline.Name
+		},
+	}
+	jobInfos := NewCollection(etcdClient, uuidPrefix, []*Index{pipelineNameIndex}, &pps.JobInfo{}, nil, nil)
+
+	j1 := &pps.JobInfo{
+		Job:      client.NewJob("j1"),
+		Pipeline: client.NewPipeline("p1"),
+	}
+	j2 := &pps.JobInfo{
+		Job:      client.NewJob("j2"),
+		Pipeline: client.NewPipeline("p2"),
+	}
+	_, err := NewSTM(context.Background(), etcdClient, func(stm STM) error {
+		jobInfos := jobInfos.ReadWrite(stm)
+		jobInfos.Put(j1.Job.ID, j1)
+		jobInfos.Put(j2.Job.ID, j2)
+		return nil
+	})
+	require.NoError(t, err)
+
+	jobInfosReadonly := jobInfos.ReadOnly(context.Background())
+	job := &pps.JobInfo{}
+	var ids []string
+	require.NoError(t, jobInfosReadonly.GetByIndex(pipelineNameIndex, "p1", job, DefaultOptions, func(ID string) error {
+		ids = append(ids, ID)
+		return nil
+	}))
+	require.Equal(t, []string{"j1"}, ids)
+
+	// Moving a job to another pipeline moves its index entry transactionally
+	j1.Pipeline = client.NewPipeline("p2")
+	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+		return jobInfos.ReadWrite(stm).Put(j1.Job.ID, j1)
+	})
+	require.NoError(t, err)
+	ids = nil
+	require.NoError(t, jobInfosReadonly.GetByIndex(pipelineNameIndex, "p1", job, DefaultOptions, func(ID string) error {
+		ids = append(ids, ID)
+		return nil
+	}))
+	require.Equal(t, 0, len(ids))
+	ids = nil
+	require.NoError(t, jobInfosReadonly.GetByIndex(pipelineNameIndex, "p2", job, DefaultOptions, func(ID string) error {
+		ids = append(ids, ID)
+		return nil
+	}))
+	require.ElementsEqual(t, []string{"j1", "j2"}, ids)
+}
+
 func TestIndexWatch(t *testing.T) {
 	etcdClient := getEtcdClient()
 	uuidPrefix := uuid.NewWithoutDashes()
@@ -660,6 +720,295 @@ func TestIteration(t *testing.T) {
 	})
 }
 
+func TestListPaged(t *testing.T) {
+	etcdClient := getEtcdClient()
+	uuidPrefix := uuid.NewWithoutDashes()
+	col := NewCollection(etcdClient, uuidPrefix, nil, &types.Empty{}, nil, nil)
+	numVals := 25
+	for i := 0; i < numVals; i++ {
+		_, err := NewSTM(context.Background(), etcdClient, func(stm STM) error {
+			return col.ReadWrite(stm).Put(fmt.Sprintf("%03d", i), &types.Empty{})
+		})
+		require.NoError(t, err)
+	}
+	ro := col.ReadOnly(context.Background())
+	val := &types.Empty{}
+
+	// Page through the collection and check that every key arrives exactly
+	// once, in key order
+	var keys []string
+	token := ""
+	pages := 0
+	for {
+		next, err := ro.ListPaged(val, 10, token, func(key string) error {
+			keys = append(keys, key)
+			return nil
+		})
+		require.NoError(t, err)
+		pages++
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	require.Equal(t, 3, pages)
+	require.Equal(t, numVals, len(keys))
+	for i, key := range keys {
+		require.Equal(t, fmt.Sprintf("%03d", i), key)
+	}
+
+	// A token past the last key yields an empty page and no further token
+	next, err := ro.ListPaged(val, 10, keys[len(keys)-1], func(key string) error {
+		t.Fatalf("unexpected key %s", key)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "", next)
+}
+
+func TestCountExists(t *testing.T) {
+	etcdClient := getEtcdClient()
+	uuidPrefix := uuid.NewWithoutDashes()
+	col := NewCollection(etcdClient, uuidPrefix, nil, &types.Empty{}, nil, nil)
+	_, err := NewSTM(context.Background(), etcdClient, func(stm STM) error {
+		rw := col.ReadWrite(stm)
+		for _, key := range []string{"sub/a", "sub/b", "other"} {
+			if err := rw.Put(key, &types.Empty{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	ro := col.ReadOnly(context.Background())
+	count, err := ro.Count()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+	count, err = ro.CountPrefix("sub")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	exists, err := ro.Exists("other")
+	require.NoError(t, err)
+	require.True(t, exists)
+	exists, err = ro.Exists("missing")
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestGetManyPutMany(t *testing.T) {
+	etcdClient := getEtcdClient()
+	uuidPrefix := uuid.NewWithoutDashes()
+
+	jobInfos := NewCollection(etcdClient, uuidPrefix, nil, &pps.JobInfo{}, nil, nil)
+
+	vals := map[string]proto.Message{
+		"j1": &pps.JobInfo{Job: client.NewJob("j1")},
+		"j2": &pps.JobInfo{Job: client.NewJob("j2")},
+		"j3": &pps.JobInfo{Job: client.NewJob("j3")},
+	}
+	_, err := NewSTM(context.Background(), etcdClient, func(stm STM) error {
+		return jobInfos.ReadWrite(stm).PutMany(vals)
+	})
+	require.NoError(t, err)
+
+	var gotKeys []string
+	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+		gotKeys = nil
+		return jobInfos.ReadWrite(stm).GetMany([]string{"j1", "j2", "j3"}, func(key string, val proto.Message) error {
+			require.Equal(t, key, val.(*pps.JobInfo).Job.ID)
+			gotKeys = append(gotKeys, key)
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"j1", "j2", "j3"}, gotKeys)
+
+	// missing keys surface the usual ErrNotFound
+	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+		return jobInfos.ReadWrite(stm).GetMany([]string{"nonexistent"}, func(key string, val proto.Message) error {
+			return nil
+		})
+	})
+	require.True(t, IsErrNotFound(err))
+}
+
+func TestPutManyChunked(t *testing.T) {
+	etcdClient := getEtcdClient()
+	uuidPrefix := uuid.NewWithoutDashes()
+
+	jobInfos := NewCollection(etcdClient, uuidPrefix, nil, &pps.JobInfo{}, nil, nil)
+
+	// write more keys than fit in one chunk
+	vals := make(map[string]proto.Message)
+	for i := 0; i < 150; i++ {
+		key := fmt.Sprintf("job%03d", i)
+		vals[key] = &pps.JobInfo{Job: client.NewJob(key)}
+	}
+	require.NoError(t, jobInfos.PutManyChunked(context.Background(), vals))
+
+	count, err := jobInfos.ReadOnly(context.Background()).Count()
+	require.NoError(t, err)
+	require.Equal(t, int64(150), count)
+}
+
+func TestWatchPrefixAndFiltered(t *testing.T) {
+	etcdClient := getEtcdClient()
+	uuidPrefix := uuid.NewWithoutDashes()
+
+	jobInfos := NewCollection(etcdClient, uuidPrefix, nil, &pps.JobInfo{}, nil, nil)
+	readonly := jobInfos.ReadOnly(context.Background())
+
+	// WatchPrefix only sees keys under the prefix
+	prefixWatcher, err := readonly.WatchPrefix("p1-")
+	require.NoError(t, err)
+	defer prefixWatcher.Close()
+
+	// WatchFiltered only sees values matching the predicate
+	filteredWatcher, err := readonly.WatchFiltered(func(key string, val proto.Message) (bool, error) {
+		return val.(*pps.JobInfo).Pipeline.Name == "p2", nil
+	})
+	require.NoError(t, err)
+	defer filteredWatcher.Close()
+
+	j1 := &pps.JobInfo{Job: client.NewJob("p1-j1"), Pipeline: client.NewPipeline("p1")}
+	j2 := &pps.JobInfo{Job: client.NewJob("p2-j1"), Pipeline: client.NewPipeline("p2")}
+	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+		jobInfos := jobInfos.ReadWrite(stm)
+		jobInfos.Put(j1.Job.ID, j1)
+		jobInfos.Put(j2.Job.ID, j2)
+		return nil
+	})
+	require.NoError(t, err)
+
+	var key string
+	job := new(pps.JobInfo)
+	event := <-prefixWatcher.Watch()
+	require.NoError(t, event.Err)
+	require.Equal(t, watch.EventPut, event.Type)
+	require.NoError(t, event.Unmarshal(&key, job))
+	require.Equal(t, j1.Job.ID, key)
+
+	event = <-filteredWatcher.Watch()
+	require.NoError(t, event.Err)
+	require.Equal(t, watch.EventPut, event.Type)
+	require.NoError(t, event.Unmarshal(&key, job))
+	require.Equal(t, j2.Job.ID, key)
+
+	// neither watcher delivers the other key
+	select {
+	case event := <-prefixWatcher.Watch():
+		t.Fatalf("unexpected event on prefix watcher: %v", event)
+	case event := <-filteredWatcher.Watch():
+		t.Fatalf("unexpected event on filtered watcher: %v", event)
+	case <-time.After(2 * time.Second):
+	}
+}
+
+func TestPutLease(t *testing.T) {
+	etcdClient := getEtcdClient()
+	uuidPrefix := uuid.NewWithoutDashes()
+
+	jobInfos := NewCollection(etcdClient, uuidPrefix, nil, &pps.JobInfo{}, nil, nil)
+
+	lease, err := NewLease(context.Background(), etcdClient, 30)
+	require.NoError(t, err)
+
+	// attach two keys to the shared lease
+	j1 := &pps.JobInfo{Job: client.NewJob("j1")}
+	j2 := &pps.JobInfo{Job: client.NewJob("j2")}
+	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+		rw := jobInfos.ReadWrite(stm)
+		if err := rw.PutLease(j1.Job.ID, j1, lease); err != nil {
+			return err
+		}
+		return rw.PutLease(j2.Job.ID, j2, lease)
+	})
+	require.NoError(t, err)
+
+	readonly := jobInfos.ReadOnly(context.Background())
+	ttl, err := readonly.TTL("j1")
+	require.NoError(t, err)
+	require.True(t, ttl > 0 && ttl <= 30, "ttl was %d", ttl)
+
+	require.NoError(t, lease.KeepAliveOnce(context.Background()))
+
+	// revoking the lease deletes every attached key
+	require.NoError(t, lease.Revoke(context.Background()))
+	job := new(pps.JobInfo)
+	require.True(t, IsErrNotFound(readonly.Get("j1", job)))
+	require.True(t, IsErrNotFound(readonly.Get("j2", job)))
+}
+
+func TestPutIfRev(t *testing.T) {
+	etcdClient := getEtcdClient()
+	uuidPrefix := uuid.NewWithoutDashes()
+
+	jobInfos := NewCollection(etcdClient, uuidPrefix, nil, &pps.JobInfo{}, nil, nil)
+	readonly := jobInfos.ReadOnly(context.Background())
+
+	// rev 0 creates the key iff it doesn't exist
+	j1 := &pps.JobInfo{Job: client.NewJob("j1")}
+	require.NoError(t, jobInfos.PutIfRev(context.Background(), "j1", j1, 0))
+	require.True(t, IsErrModified(jobInfos.PutIfRev(context.Background(), "j1", j1, 0)))
+
+	// read-modify-write with the revision from the read succeeds once
+	job := new(pps.JobInfo)
+	rev, err := readonly.GetRev("j1", job)
+	require.NoError(t, err)
+	job.Pipeline = client.NewPipeline("p1")
+	require.NoError(t, jobInfos.PutIfRev(context.Background(), "j1", job, rev))
+
+	// a second write with the stale revision is rejected
+	job.Pipeline = client.NewPipeline("p2")
+	require.True(t, IsErrModified(jobInfos.PutIfRev(context.Background(), "j1", job, rev)))
+
+	require.NoError(t, readonly.Get("j1", job))
+	require.Equal(t, "p1", job.Pipeline.Name)
+}
+
+func TestSnapshotSTM(t *testing.T) {
+	etcdClient := getEtcdClient()
+	uuidPrefix := uuid.NewWithoutDashes()
+
+	jobInfos := NewCollection(etcdClient, uuidPrefix, nil, &pps.JobInfo{}, nil, nil)
+
+	j1 := &pps.JobInfo{Job: client.NewJob("j1"), Pipeline: client.NewPipeline("p1")}
+	_, err := NewSTM(context.Background(), etcdClient, func(stm STM) error {
+		return jobInfos.ReadWrite(stm).Put(j1.Job.ID, j1)
+	})
+	require.NoError(t, err)
+
+	// pin a snapshot, then modify the key behind it
+	job := new(pps.JobInfo)
+	rev, err := NewSnapshotSTM(context.Background(), etcdClient, 0, func(stm STM) error {
+		return jobInfos.ReadWrite(stm).Get("j1", job)
+	})
+	require.NoError(t, err)
+	require.Equal(t, "p1", job.Pipeline.Name)
+
+	j1Prime := &pps.JobInfo{Job: client.NewJob("j1"), Pipeline: client.NewPipeline("p2")}
+	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+		return jobInfos.ReadWrite(stm).Put(j1.Job.ID, j1Prime)
+	})
+	require.NoError(t, err)
+
+	// reads at the pinned revision still see the old value
+	_, err = NewSnapshotSTM(context.Background(), etcdClient, rev, func(stm STM) error {
+		return jobInfos.ReadWrite(stm).Get("j1", job)
+	})
+	require.NoError(t, err)
+	require.Equal(t, "p1", job.Pipeline.Name)
+
+	// a fresh snapshot sees the new value
+	_, err = NewSnapshotSTM(context.Background(), etcdClient, 0, func(stm STM) error {
+		return jobInfos.ReadWrite(stm).Get("j1", job)
+	})
+	require.NoError(t, err)
+	require.Equal(t, "p2", job.Pipeline.Name)
+}
+
 var etcdClient *etcd.Client
 var etcdClientOnce sync.Once
 
@@ -56,3 +56,20 @@ func IsErrMalformedValue(e error) bool {
 	_, ok := e.(ErrMalformedValue)
 	return ok
 }
+
+// ErrModified indicates that a conditional write was rejected because the key
+// was modified (or deleted) since the revision the caller read it at.
+type ErrModified struct {
+	Type string
+	Key  string
+}
+
+func (e ErrModified) Error() string {
+	return fmt.Sprintf("%s %s was modified since it was read", strings.TrimPrefix(e.Type, DefaultPrefix), e.Key)
+}
+
+// IsErrModified determines if an error is an ErrModified error
+func IsErrModified(e error) bool {
+	_, ok := e.(ErrModified)
+	return ok
+}
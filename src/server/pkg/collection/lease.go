@@ -0,0 +1,75 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+
+	etcd "github.com/coreos/etcd/clientv3"
+)
+
+// Lease is a shared, renewable etcd lease. PutTTL grants a fresh lease for
+// every write, which churns through leases quickly for collections like
+// tokens and OTPs that write many short-lived keys; instead, callers can
+// grant one Lease, attach any number of keys to it with
+// ReadWriteCollection.PutLease, and renew them all together with KeepAlive.
+// All the attached keys expire together when the lease does.
+type Lease struct {
+	client *etcd.Client
+	id     etcd.LeaseID
+	ttl    int64
+}
+
+// NewLease grants a lease with a TTL of 'ttl' seconds.
+func NewLease(ctx context.Context, client *etcd.Client, ttl int64) (*Lease, error) {
+	resp, err := client.Grant(ctx, ttl)
+	if err != nil {
+		return nil, fmt.Errorf("error granting lease: %v", err)
+	}
+	return &Lease{client: client, id: resp.ID, ttl: ttl}, nil
+}
+
+// ID returns the etcd lease ID.
+func (l *Lease) ID() etcd.LeaseID {
+	return l.id
+}
+
+// TTL returns the TTL (in seconds) that the lease was granted with.
+func (l *Lease) TTL() int64 {
+	return l.ttl
+}
+
+// KeepAliveOnce renews the lease once, resetting its remaining time to the
+// granted TTL.
+func (l *Lease) KeepAliveOnce(ctx context.Context) error {
+	_, err := l.client.KeepAliveOnce(ctx, l.id)
+	return err
+}
+
+// KeepAlive renews the lease continuously until ctx is cancelled, blocking
+// while it does so. It returns nil once ctx is done; if the keepalive stream
+// closes early (e.g. the lease was revoked or expired), it returns an error.
+func (l *Lease) KeepAlive(ctx context.Context) error {
+	ch, err := l.client.KeepAlive(ctx, l.id)
+	if err != nil {
+		return err
+	}
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return fmt.Errorf("keepalive channel for lease %d closed unexpectedly", l.id)
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Revoke revokes the lease, deleting every key attached to it.
+func (l *Lease) Revoke(ctx context.Context) error {
+	_, err := l.client.Revoke(ctx, l.id)
+	return err
+}
@@ -0,0 +1,390 @@
+package collection
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// ErrUnsupportedByBackend indicates that an operation isn't implemented by
+// the storage backend a collection runs on (e.g. watches on Postgres).
+type ErrUnsupportedByBackend struct {
+	Backend   string
+	Operation string
+}
+
+func (e ErrUnsupportedByBackend) Error() string {
+	return fmt.Sprintf("%s is not supported by the %s backend", e.Operation, e.Backend)
+}
+
+// IsErrUnsupportedByBackend determines if an error is an
+// ErrUnsupportedByBackend error
+func IsErrUnsupportedByBackend(e error) bool {
+	_, ok := e.(ErrUnsupportedByBackend)
+	return ok
+}
+
+// PostgresCollection is a collection backed by a Postgres table instead of
+// etcd. It exists for auth state (tokens, ACLs) that can outgrow etcd's
+// value-size and throughput limits; the table holds one row per item, with
+// the marshalled protobuf as a bytea column.
+//
+// The database handle is plain database/sql, so callers must import a
+// Postgres driver (e.g. github.com/lib/pq) in their binary. Features that
+// lean on etcd specifics -- watches, TTLs, and secondary indexes -- return
+// ErrUnsupportedByBackend; collections that need them should stay on etcd.
+type PostgresCollection struct {
+	db       *sql.DB
+	table    string
+	template proto.Message
+	keyCheck func(string) error
+	valCheck func(proto.Message) error
+}
+
+// NewPostgresCollection returns a collection backed by the Postgres table
+// 'table', creating the table if it doesn't exist. Aside from the unsupported
+// features noted on PostgresCollection, it behaves like an etcd collection
+// with the same template and check functions.
+func NewPostgresCollection(ctx context.Context, db *sql.DB, table string, template proto.Message, keyCheck func(string) error, valCheck func(proto.Message) error) (*PostgresCollection, error) {
+	if _, err := db.ExecContext(ctx, fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (key TEXT PRIMARY KEY, value BYTEA NOT NULL)", table)); err != nil {
+		return nil, err
+	}
+	return &PostgresCollection{
+		db:       db,
+		table:    table,
+		template: template,
+		keyCheck: keyCheck,
+		valCheck: valCheck,
+	}, nil
+}
+
+// ReadOnly returns a read-only view of the collection.
+func (c *PostgresCollection) ReadOnly(ctx context.Context) ReadonlyCollection {
+	return &postgresReadonlyCollection{c: c, ctx: ctx}
+}
+
+// ReadWriteTx runs 'f' with a read-write view of the collection inside a
+// single SQL transaction, committing if 'f' returns nil and rolling back
+// otherwise. It's the Postgres analogue of running a ReadWriteCollection
+// inside an STM.
+func (c *PostgresCollection) ReadWriteTx(ctx context.Context, f func(rw ReadWriteCollection) error) (retErr error) {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			tx.Rollback()
+		}
+	}()
+	rw := &postgresReadWriteCollection{c: c, ctx: ctx, tx: tx}
+	if err := f(rw); err != nil {
+		return err
+	}
+	// DeleteAll and DeleteAllPrefix can't return errors, so they stash them
+	// here to fail the transaction
+	if rw.err != nil {
+		return rw.err
+	}
+	return tx.Commit()
+}
+
+type postgresReadWriteCollection struct {
+	c   *PostgresCollection
+	ctx context.Context
+	tx  *sql.Tx
+	err error // deferred error from methods that can't return one
+}
+
+func (c *postgresReadWriteCollection) unsupported(op string) error {
+	return ErrUnsupportedByBackend{Backend: "postgres", Operation: op}
+}
+
+func (c *postgresReadWriteCollection) Get(key string, val proto.Message) error {
+	var value []byte
+	if err := c.tx.QueryRowContext(c.ctx, fmt.Sprintf(
+		"SELECT value FROM %s WHERE key = $1", c.c.table), key).Scan(&value); err != nil {
+		if err == sql.ErrNoRows {
+			return ErrNotFound{c.c.table, key}
+		}
+		return err
+	}
+	return proto.Unmarshal(value, val)
+}
+
+func (c *postgresReadWriteCollection) Put(key string, val proto.Message) error {
+	if c.c.keyCheck != nil {
+		if err := c.c.keyCheck(key); err != nil {
+			return err
+		}
+	}
+	if c.c.valCheck != nil {
+		if err := c.c.valCheck(val); err != nil {
+			return err
+		}
+	}
+	value, err := proto.Marshal(val)
+	if err != nil {
+		return err
+	}
+	_, err = c.tx.ExecContext(c.ctx, fmt.Sprintf(
+		"INSERT INTO %s (key, value) VALUES ($1, $2) ON CONFLICT (key) DO UPDATE SET value = $2", c.c.table), key, value)
+	return err
+}
+
+func (c *postgresReadWriteCollection) TTL(key string) (int64, error) {
+	return 0, c.unsupported("TTL")
+}
+
+func (c *postgresReadWriteCollection) PutTTL(key string, val proto.Message, ttl int64) error {
+	return c.unsupported("PutTTL")
+}
+
+func (c *postgresReadWriteCollection) PutLease(key string, val proto.Message, l *Lease) error {
+	return c.unsupported("PutLease")
+}
+
+func (c *postgresReadWriteCollection) Update(key string, val proto.Message, f func() error) error {
+	if err := c.Get(key, val); err != nil {
+		return err
+	}
+	if err := f(); err != nil {
+		return err
+	}
+	return c.Put(key, val)
+}
+
+func (c *postgresReadWriteCollection) Upsert(key string, val proto.Message, f func() error) error {
+	if err := c.Get(key, val); err != nil && !IsErrNotFound(err) {
+		return err
+	}
+	if err := f(); err != nil {
+		return err
+	}
+	return c.Put(key, val)
+}
+
+func (c *postgresReadWriteCollection) GetMany(keys []string, f func(key string, val proto.Message) error) error {
+	for _, key := range keys {
+		val := proto.Clone(c.c.template)
+		if err := c.Get(key, val); err != nil {
+			return err
+		}
+		if err := f(key, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *postgresReadWriteCollection) PutMany(vals map[string]proto.Message) error {
+	for key, val := range vals {
+		if err := c.Put(key, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *postgresReadWriteCollection) Create(key string, val proto.Message) error {
+	if err := c.Get(key, proto.Clone(c.c.template)); err == nil {
+		return ErrExists{c.c.table, key}
+	} else if !IsErrNotFound(err) {
+		return err
+	}
+	return c.Put(key, val)
+}
+
+func (c *postgresReadWriteCollection) Delete(key string) error {
+	res, err := c.tx.ExecContext(c.ctx, fmt.Sprintf(
+		"DELETE FROM %s WHERE key = $1", c.c.table), key)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound{c.c.table, key}
+	}
+	return nil
+}
+
+func (c *postgresReadWriteCollection) DeleteAll() {
+	if _, err := c.tx.ExecContext(c.ctx, fmt.Sprintf(
+		"DELETE FROM %s", c.c.table)); err != nil && c.err == nil {
+		c.err = err
+	}
+}
+
+func (c *postgresReadWriteCollection) DeleteAllPrefix(prefix string) {
+	if _, err := c.tx.ExecContext(c.ctx, fmt.Sprintf(
+		"DELETE FROM %s WHERE key LIKE $1", c.c.table), likePrefix(prefix)); err != nil && c.err == nil {
+		c.err = err
+	}
+}
+
+type postgresReadonlyCollection struct {
+	c   *PostgresCollection
+	ctx context.Context
+}
+
+func (c *postgresReadonlyCollection) unsupported(op string) error {
+	return ErrUnsupportedByBackend{Backend: "postgres", Operation: op}
+}
+
+func (c *postgresReadonlyCollection) Get(key string, val proto.Message) error {
+	var value []byte
+	if err := c.c.db.QueryRowContext(c.ctx, fmt.Sprintf(
+		"SELECT value FROM %s WHERE key = $1", c.c.table), key).Scan(&value); err != nil {
+		if err == sql.ErrNoRows {
+			return ErrNotFound{c.c.table, key}
+		}
+		return err
+	}
+	return proto.Unmarshal(value, val)
+}
+
+func (c *postgresReadonlyCollection) GetRev(key string, val proto.Message) (int64, error) {
+	return 0, c.unsupported("GetRev")
+}
+
+func (c *postgresReadonlyCollection) GetByIndex(index *Index, indexVal interface{}, val proto.Message, opts *Options, f func(key string) error) error {
+	return c.unsupported("GetByIndex")
+}
+
+func (c *postgresReadonlyCollection) GetBlock(key string, val proto.Message) error {
+	return c.unsupported("GetBlock")
+}
+
+func (c *postgresReadonlyCollection) TTL(key string) (int64, error) {
+	return 0, c.unsupported("TTL")
+}
+
+// list runs 'query' (which must select key, value) and calls f for each row
+// after unmarshalling the value into 'val'.
+func (c *postgresReadonlyCollection) list(query string, args []interface{}, val proto.Message, f func(key string) error) error {
+	rows, err := c.c.db.QueryContext(c.ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key string
+		var value []byte
+		if err := rows.Scan(&key, &value); err != nil {
+			return err
+		}
+		if err := proto.Unmarshal(value, val); err != nil {
+			return err
+		}
+		if err := f(key); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (c *postgresReadonlyCollection) List(val proto.Message, opts *Options, f func(key string) error) error {
+	return c.list(fmt.Sprintf("SELECT key, value FROM %s ORDER BY key", c.c.table), nil, val, f)
+}
+
+func (c *postgresReadonlyCollection) ListPaged(val proto.Message, limit int64, continueToken string, f func(key string) error) (string, error) {
+	var lastKey string
+	var count int64
+	if err := c.list(fmt.Sprintf(
+		"SELECT key, value FROM %s WHERE key > $1 ORDER BY key LIMIT $2", c.c.table),
+		[]interface{}{continueToken, limit + 1}, val, func(key string) error {
+			count++
+			if count > limit {
+				return nil // the extra row only signals that more rows exist
+			}
+			lastKey = key
+			return f(key)
+		}); err != nil {
+		return "", err
+	}
+	if count <= limit {
+		return "", nil
+	}
+	return lastKey, nil
+}
+
+func (c *postgresReadonlyCollection) ListPrefix(prefix string, val proto.Message, opts *Options, f func(string) error) error {
+	return c.list(fmt.Sprintf(
+		"SELECT key, value FROM %s WHERE key LIKE $1 ORDER BY key", c.c.table),
+		[]interface{}{likePrefix(prefix)}, val, f)
+}
+
+func (c *postgresReadonlyCollection) Count() (int64, error) {
+	var count int64
+	err := c.c.db.QueryRowContext(c.ctx, fmt.Sprintf(
+		"SELECT count(*) FROM %s", c.c.table)).Scan(&count)
+	return count, err
+}
+
+func (c *postgresReadonlyCollection) CountPrefix(prefix string) (int64, error) {
+	var count int64
+	err := c.c.db.QueryRowContext(c.ctx, fmt.Sprintf(
+		"SELECT count(*) FROM %s WHERE key LIKE $1", c.c.table), likePrefix(prefix)).Scan(&count)
+	return count, err
+}
+
+func (c *postgresReadonlyCollection) Exists(key string) (bool, error) {
+	var exists bool
+	err := c.c.db.QueryRowContext(c.ctx, fmt.Sprintf(
+		"SELECT EXISTS (SELECT 1 FROM %s WHERE key = $1)", c.c.table), key).Scan(&exists)
+	return exists, err
+}
+
+func (c *postgresReadonlyCollection) Watch(opts ...watch.OpOption) (watch.Watcher, error) {
+	return nil, c.unsupported("Watch")
+}
+
+func (c *postgresReadonlyCollection) WatchBuffered(pageSize int64, opts ...watch.OpOption) (watch.Watcher, error) {
+	return nil, c.unsupported("WatchBuffered")
+}
+
+func (c *postgresReadonlyCollection) WatchFrom(rev int64, opts ...watch.OpOption) (watch.Watcher, error) {
+	return nil, c.unsupported("WatchFrom")
+}
+
+func (c *postgresReadonlyCollection) WatchPrefix(prefix string, opts ...watch.OpOption) (watch.Watcher, error) {
+	return nil, c.unsupported("WatchPrefix")
+}
+
+func (c *postgresReadonlyCollection) WatchFiltered(f func(key string, val proto.Message) (bool, error), opts ...watch.OpOption) (watch.Watcher, error) {
+	return nil, c.unsupported("WatchFiltered")
+}
+
+func (c *postgresReadonlyCollection) WatchOne(key string) (watch.Watcher, error) {
+	return nil, c.unsupported("WatchOne")
+}
+
+func (c *postgresReadonlyCollection) WatchOneF(key string, f func(*watch.Event) error) error {
+	return c.unsupported("WatchOneF")
+}
+
+func (c *postgresReadonlyCollection) WatchByIndex(index *Index, val interface{}) (watch.Watcher, error) {
+	return nil, c.unsupported("WatchByIndex")
+}
+
+// likePrefix escapes LIKE metacharacters in 'prefix' and appends the
+// match-anything wildcard, so user-controlled keys can't alter the pattern.
+func likePrefix(prefix string) string {
+	escaped := make([]byte, 0, len(prefix)+1)
+	for i := 0; i < len(prefix); i++ {
+		switch prefix[i] {
+		case '%', '_', '\\':
+			escaped = append(escaped, '\\')
+		}
+		escaped = append(escaped, prefix[i])
+	}
+	return string(escaped) + "%"
+}
@@ -36,6 +36,11 @@ type STM interface {
 	Get(key string) (string, error)
 	// Put adds a value for a key to the write set.
 	Put(key, val string, ttl int64, ptr uintptr) error
+	// PutLease is like Put, but attaches the key to an already-granted lease
+	// (see Lease) instead of granting a fresh one, so many keys across many
+	// txns can share a single renewable lease. 'ttl' is the lease's granted
+	// TTL, used only to answer same-txn TTL() reads.
+	PutLease(key, val string, lease v3.LeaseID, ttl int64, ptr uintptr) error
 	// Rev returns the revision of a key in the read set.
 	Rev(key string) int64
 	// Del deletes a key.
@@ -74,6 +79,30 @@ func NewDryrunSTM(ctx context.Context, c *v3.Client, apply func(STM) error) erro
 	return err
 }
 
+// NewSnapshotSTM initiates a new read-only STM whose reads are all pinned at
+// one etcd revision: 'rev', or the store's current revision if rev <= 0. Any
+// writes are discarded, as with NewDryrunSTM. It returns the revision that
+// reads were pinned at, so several calls can share a snapshot by passing the
+// first call's revision to the rest. Unlike the serializable model, which
+// pins the revision at the first read, the snapshot revision is fixed before
+// 'apply' runs, so concurrent writes can never be observed partially.
+func NewSnapshotSTM(ctx context.Context, c *v3.Client, rev int64, apply func(STM) error) (int64, error) {
+	if rev <= 0 {
+		// any ranged read returns the store's current revision in its header;
+		// "\x00" keeps the probe as cheap as possible
+		resp, err := c.Get(ctx, "\x00", v3.WithSerializable())
+		if err != nil {
+			return 0, err
+		}
+		rev = resp.Header.Revision
+	}
+	s := &stm{client: c, ctx: ctx, getOpts: []v3.OpOption{v3.WithRev(rev), v3.WithSerializable()}}
+	if _, err := runSTM(s, apply, true); err != nil {
+		return 0, err
+	}
+	return rev, nil
+}
+
 // newSTMRepeatable initiates new repeatable read transaction; reads within
 // the same transaction attempt to always return the same data.
 func newSTMRepeatable(ctx context.Context, c *v3.Client, apply func(STM) error) (*v3.TxnResponse, error) {
@@ -223,6 +252,16 @@ func (s *stm) Put(key, val string, ttl int64, ptr uintptr) error {
 	return nil
 }
 
+func (s *stm) PutLease(key, val string, lease v3.LeaseID, ttl int64, ptr uintptr) error {
+	var options []v3.OpOption
+	if lease != v3.NoLease {
+		options = append(options, v3.WithLease(lease))
+		s.ttlset[key] = ttl // cache key->ttl, in case it's read later
+	}
+	s.wset[key] = stmPut{val, ttl, v3.OpPut(key, val, options...), ptr}
+	return nil
+}
+
 func (s *stm) Del(key string) {
 	s.wset[key] = stmPut{"", 0, v3.OpDelete(key), 0}
 }
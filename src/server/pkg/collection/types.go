@@ -30,6 +30,13 @@ type Collection interface {
 	// Claim attempts to claim a key and run the passed in callback with
 	// the context for the claim.
 	Claim(ctx context.Context, key string, val proto.Message, f func(context.Context) error) error
+	// PutManyChunked writes 'vals' in chunks of bounded size, one etcd txn
+	// per chunk. Chunks commit atomically; the write as a whole does not.
+	PutManyChunked(ctx context.Context, vals map[string]proto.Message) error
+	// PutIfRev writes 'val' under 'key' only if the key's mod revision still
+	// equals 'rev' (0 means the key must not exist), returning ErrModified
+	// otherwise.
+	PutIfRev(ctx context.Context, key string, val proto.Message, rev int64) error
 }
 
 // Index specifies a secondary index on a collection.
@@ -53,10 +60,17 @@ type Collection interface {
 // Multi specifies whether this is a multi-index.  A multi-index is an index
 // on a field that's a slice.  The item is then indexed on each element of
 // the slice.
+//
+// Extract, if set, derives the indexed value from the item instead of reading
+// the field named by Field. This allows indexing on values that aren't
+// top-level fields (e.g. ACL entries by principal, where the principals are
+// the keys of a map inside the ACL). For a multi-index, Extract must return a
+// slice. The index is still maintained transactionally with the item itself.
 type Index struct {
-	Field string
-	Multi bool
-	limit int64
+	Field   string
+	Multi   bool
+	Extract func(val interface{}) interface{}
+	limit   int64
 }
 
 // ReadWriteCollection is a collection interface that supports read,write and delete
@@ -73,12 +87,21 @@ type ReadWriteCollection interface {
 	// can result in inconsistency, as the indices are removed at roughly
 	// but not exactly the same time as the documents.
 	PutTTL(key string, val proto.Message, ttl int64) error
+	// PutLease is like Put, but attaches the key to the shared lease 'l'
+	// (see Lease) instead of granting a fresh lease per write.
+	PutLease(key string, val proto.Message, l *Lease) error
 	// Update reads the current value associated with 'key', calls 'f' to update
 	// the value, and writes the new value back to the collection. 'key' must be
 	// present in the collection, or a 'Not Found' error is returned
 	Update(key string, val proto.Message, f func() error) error
 	// Upsert is like Update but 'key' is not required to be present
 	Upsert(key string, val proto.Message, f func() error) error
+	// GetMany gets several keys in one pass, calling f with each key and a
+	// freshly-cloned value.
+	GetMany(keys []string, f func(key string, val proto.Message) error) error
+	// PutMany puts several key/value pairs, staged in the collection's STM
+	// so they commit atomically.
+	PutMany(vals map[string]proto.Message) error
 	Create(key string, val proto.Message) error
 	Delete(key string) error
 	DeleteAll()
@@ -99,6 +122,9 @@ type ReadWriteIntCollection interface {
 // ReadonlyCollection is a collection interface that only supports read ops.
 type ReadonlyCollection interface {
 	Get(key string, val proto.Message) error
+	// GetRev is like Get, but also returns the key's etcd mod revision, for
+	// use with Collection.PutIfRev.
+	GetRev(key string, val proto.Message) (int64, error)
 	GetByIndex(index *Index, indexVal interface{}, val proto.Message, opts *Options, f func(key string) error) error
 	// GetBlock is like Get but waits for the key to exist if it doesn't already.
 	GetBlock(key string, val proto.Message) error
@@ -106,9 +132,30 @@ type ReadonlyCollection interface {
 	// collection, or '0' if 'key' will remain in the collection indefinitely
 	TTL(key string) (int64, error)
 	List(val proto.Message, opts *Options, f func(key string) error) error
+	// ListPaged lists up to 'limit' items in key order, resuming after
+	// 'continueToken'; it returns the token to pass to the next call, or ""
+	// once the collection is exhausted.
+	ListPaged(val proto.Message, limit int64, continueToken string, f func(key string) error) (string, error)
 	ListPrefix(prefix string, val proto.Message, opts *Options, f func(string) error) error
 	Count() (int64, error)
+	// CountPrefix returns the number of keys that begin with 'prefix' (the
+	// same string-prefix match as ListPrefix), without fetching their values.
+	CountPrefix(prefix string) (int64, error)
+	// Exists reports whether 'key' is present, without fetching its value.
+	Exists(key string) (bool, error)
 	Watch(opts ...watch.OpOption) (watch.Watcher, error)
+	// WatchBuffered is like Watch, but pages the initial listing and buffers
+	// event delivery, bounding the watcher's memory use.
+	WatchBuffered(pageSize int64, opts ...watch.OpOption) (watch.Watcher, error)
+	// WatchFrom resumes a watch from an etcd revision, skipping the initial
+	// listing.
+	WatchFrom(rev int64, opts ...watch.OpOption) (watch.Watcher, error)
+	// WatchPrefix watches only the keys of the collection starting with
+	// 'prefix'.
+	WatchPrefix(prefix string, opts ...watch.OpOption) (watch.Watcher, error)
+	// WatchFiltered only delivers put events whose value satisfies 'f'
+	// (deletes, which carry no value, are always delivered).
+	WatchFiltered(f func(key string, val proto.Message) (bool, error), opts ...watch.OpOption) (watch.Watcher, error)
 	WatchOne(key string) (watch.Watcher, error)
 	WatchOneF(key string, f func(*watch.Event) error) error
 	WatchByIndex(index *Index, val interface{}) (watch.Watcher, error)
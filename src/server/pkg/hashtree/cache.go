@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sync"
 
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/pachyderm/pachyderm/src/server/pkg/localcache"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 )
 
@@ -35,6 +37,115 @@ func NewCache(size int) (*Cache, error) {
 	return &Cache{c}, nil
 }
 
+// poolEntry is one cached tree in a TreePool, weighted by how many bytes it
+// holds in memory.
+type poolEntry struct {
+	tree HashTree
+	size int64
+}
+
+// TreePool is a shared LRU cache of deserialized hashtrees keyed by tree
+// hash, bounded by a byte budget instead of an entry count, so PFS and worker
+// code can reuse trees across requests without guessing how many fit in
+// memory. Usage and evictions are exported as prometheus gauges. Evicted
+// db-backed trees are destroyed like Cache destroys them.
+type TreePool struct {
+	mu     sync.Mutex
+	cache  *lru.Cache
+	budget int64
+	used   int64
+
+	usedGauge      prometheus.Gauge
+	evictionsGauge prometheus.Gauge
+}
+
+// NewTreePool creates a new tree pool that holds at most 'budget' bytes of
+// deserialized trees.
+func NewTreePool(budget int64) (*TreePool, error) {
+	p := &TreePool{
+		budget: budget,
+		usedGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "pachyderm_pachd_hashtree_pool_used_bytes_gauge",
+			Help: "bytes of deserialized hashtrees held by the shared tree pool",
+		}),
+		evictionsGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "pachyderm_pachd_hashtree_pool_evictions_gauge",
+			Help: "trees evicted from the shared tree pool to stay under its byte budget",
+		}),
+	}
+	// The entry cap is a backstop; the byte budget is what actually bounds
+	// the pool
+	c, err := lru.NewWithEvict(1<<16, p.onEvict)
+	if err != nil {
+		return nil, err
+	}
+	p.cache = c
+	for _, collector := range []prometheus.Collector{p.usedGauge, p.evictionsGauge} {
+		if err := prometheus.Register(collector); err != nil {
+			// metrics may be redundantly registered; ignore these errors
+			if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+				logrus.Infof("error registering prometheus metric: %v", err)
+			}
+		}
+	}
+	return p, nil
+}
+
+// onEvict is only called by the underlying lru cache from Add/Remove calls
+// made while p.mu is held, so it must not take the lock itself.
+func (p *TreePool) onEvict(key interface{}, value interface{}) {
+	entry, ok := value.(*poolEntry)
+	if !ok {
+		logrus.Infof("non pool entry value of type: %v", reflect.TypeOf(value))
+		return
+	}
+	p.used -= entry.size
+	p.usedGauge.Set(float64(p.used))
+	p.evictionsGauge.Inc()
+	go func() {
+		tree, ok := entry.tree.(*dbHashTree)
+		if !ok {
+			return
+		}
+		if err := tree.Destroy(); err != nil {
+			logrus.Infof("failed to destroy hashtree: %v", err)
+		}
+	}()
+}
+
+// Get returns the cached tree with the given hash, if any.
+func (p *TreePool) Get(hash string) (HashTree, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	value, ok := p.cache.Get(hash)
+	if !ok {
+		return nil, false
+	}
+	return value.(*poolEntry).tree, true
+}
+
+// Add caches 'tree' under its hash, weighted as 'size' bytes, evicting the
+// least recently used trees until the pool is back under its budget.
+func (p *TreePool) Add(hash string, tree HashTree, size int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// Replace (and destroy) any tree already cached under this hash
+	p.cache.Remove(hash)
+	p.cache.Add(hash, &poolEntry{tree: tree, size: size})
+	p.used += size
+	p.usedGauge.Set(float64(p.used))
+	for p.used > p.budget && p.cache.Len() > 1 {
+		p.cache.RemoveOldest()
+	}
+}
+
+// Used returns how many bytes of trees the pool currently holds.
+func (p *TreePool) Used() int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.used
+}
+
 // MergeCache is an unbounded hashtree cache that can merge the hashtrees in the cache.
 type MergeCache struct {
 	*localcache.Cache
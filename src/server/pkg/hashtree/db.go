@@ -1,22 +1,27 @@
 package hashtree
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"hash"
 	"io"
+	"math/bits"
 	"os"
 	pathlib "path"
 	"regexp"
 	"sort"
 	"strings"
+	"sync"
 
 	"golang.org/x/sync/errgroup"
 
 	"github.com/OneOfOne/xxhash"
 	bolt "github.com/coreos/bbolt"
+	"github.com/golang/snappy"
 	globlib "github.com/pachyderm/glob"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
@@ -30,17 +35,25 @@ const (
 	FsBucket = "fs"
 	// ChangedBucket is the changed bucket
 	ChangedBucket = "changed"
-	perm          = 0666
+	// LinksBucket records hard-link group membership (path -> group id), so
+	// writes to one hard-linked path can be propagated to the others
+	LinksBucket = "links"
+	perm        = 0666
 	// DefaultMergeConcurrency is the default concurrency when merging hashtrees.
 	DefaultMergeConcurrency = 10
 	// IndexPath is the suffix to append to the path of a hashtree for the index.
 	IndexPath = "-index"
 	// IndexSize is the size of the index chunks.
 	IndexSize = uint64(1 << (10 * 2))
+	// serializeBufSize is the size of the buffer between a (de)serialized
+	// hashtree and the underlying reader/writer. Trees are streamed key by
+	// key, so this bounds the extra memory (de)serialization uses regardless
+	// of the size of the tree.
+	serializeBufSize = 1 << 20
 )
 
 var (
-	buckets   = []string{FsBucket, ChangedBucket}
+	buckets   = []string{FsBucket, ChangedBucket, LinksBucket}
 	exists    = []byte{1}
 	nullByte  = []byte{0}
 	slashByte = []byte{'/'}
@@ -60,8 +73,21 @@ func changed(tx *bolt.Tx) *bolt.Bucket {
 	return tx.Bucket(b(ChangedBucket))
 }
 
+func links(tx *bolt.Tx) *bolt.Bucket {
+	return tx.Bucket(b(LinksBucket))
+}
+
+// dbHashTree is safe for concurrent use: every operation is one bolt
+// transaction, and bolt serializes writers while letting readers proceed
+// against a consistent snapshot.
 type dbHashTree struct {
 	*bolt.DB
+	// hasher computes node hashes for this tree's format and algorithm
+	// (see hash.go); it's set at creation and updated by Deserialize
+	hasher *nodeHasher
+	// checkpoints are the snapshot files taken with Checkpoint that haven't
+	// been dropped; Destroy removes them along with the database
+	checkpoints []string
 }
 
 func slashEncode(b []byte) []byte {
@@ -95,6 +121,17 @@ func dbFile(storageRoot string) string {
 
 // NewDBHashTree creates a database (bolt) backed hashtree.
 func NewDBHashTree(storageRoot string) (HashTree, error) {
+	return NewDBHashTreeFormat(storageRoot, FormatV1, "")
+}
+
+// NewDBHashTreeFormat is like NewDBHashTree, but hashes nodes with the given
+// tree format and algorithm (see FormatV* and HashAlgorithm* in hash.go).
+// The format travels with the tree through Serialize and Deserialize.
+func NewDBHashTreeFormat(storageRoot string, format uint32, algorithm string) (HashTree, error) {
+	hasher, err := newNodeHasher(format, algorithm)
+	if err != nil {
+		return nil, err
+	}
 	file := dbFile(storageRoot)
 	if err := os.MkdirAll(pathlib.Dir(file), 0777); err != nil {
 		return nil, err
@@ -103,6 +140,7 @@ func NewDBHashTree(storageRoot string) (HashTree, error) {
 	if err != nil {
 		return nil, err
 	}
+	result.hasher = hasher
 	if err := result.PutDir("/"); err != nil {
 		return nil, err
 	}
@@ -121,7 +159,7 @@ func DeserializeDBHashTree(storageRoot string, r io.Reader) (_ HashTree, retErr
 	return result, nil
 }
 
-func newDBHashTree(file string) (HashTree, error) {
+func newDBHashTree(file string) (*dbHashTree, error) {
 	db, err := bolt.Open(file, perm, nil)
 	if err != nil {
 		return nil, err
@@ -139,7 +177,7 @@ func newDBHashTree(file string) (HashTree, error) {
 	}); err != nil {
 		return nil, err
 	}
-	return &dbHashTree{db}, nil
+	return &dbHashTree{DB: db, hasher: defaultHasher}, nil
 }
 
 func get(tx *bolt.Tx, path string) (*NodeProto, error) {
@@ -154,13 +192,52 @@ func get(tx *bolt.Tx, path string) (*NodeProto, error) {
 	return node, nil
 }
 
-// Get gets a hashtree node.
+// maxSymlinkHops bounds how many symlinks Get will follow before giving up
+// (the same limit the Linux kernel uses), which protects against link cycles.
+const maxSymlinkHops = 40
+
+// resolveSymlink follows the symlink at 'path' (and any symlinks it points
+// to, up to maxSymlinkHops) and returns the node it ultimately targets.
+// Absolute targets are resolved from the tree's root, relative targets from
+// the link's directory.
+func resolveSymlink(tx *bolt.Tx, path string, node *NodeProto) (*NodeProto, error) {
+	for hops := 0; node.nodetype() == symlink; hops++ {
+		if hops >= maxSymlinkHops {
+			return nil, errorf(TooManySymlinks,
+				"too many levels of symbolic links resolving %q", path)
+		}
+		target := node.SymlinkNode.Target
+		if strings.HasPrefix(target, "/") {
+			path = clean(target)
+		} else {
+			parent, _ := split(path)
+			path = join(parent, target)
+		}
+		var err error
+		node, err = get(tx, path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+// Get gets a hashtree node. If the node at 'path' is a symlink, Get follows
+// it (see resolveSymlink); List, Walk, and Glob return link nodes themselves,
+// so trees containing symlinks can be re-exported faithfully.
 func (h *dbHashTree) Get(path string) (*NodeProto, error) {
+	trace("get", path)
 	path = clean(path)
 	var node *NodeProto
 	if err := h.View(func(tx *bolt.Tx) error {
 		var err error
 		node, err = get(tx, path)
+		if err != nil {
+			return err
+		}
+		if node.nodetype() == symlink {
+			node, err = resolveSymlink(tx, path, node)
+		}
 		return err
 	}); err != nil {
 		return nil, err
@@ -227,21 +304,91 @@ func list(tx *bolt.Tx, path string, f func(*NodeProto) error) error {
 	})
 }
 
+// ListOption configures List and ListAll.
+type ListOption func(*listOptions)
+
+type listOptions struct {
+	filesOnly   bool
+	dirsOnly    bool
+	nameGlob    string
+	minSize     int64
+	omitObjects bool
+}
+
+// ListFilesOnly reports only regular files (and symlinks), skipping
+// subdirectories.
+func ListFilesOnly() ListOption {
+	return func(o *listOptions) { o.filesOnly = true }
+}
+
+// ListDirsOnly reports only subdirectories.
+func ListDirsOnly() ListOption {
+	return func(o *listOptions) { o.dirsOnly = true }
+}
+
+// ListNameGlob reports only entries whose name matches 'pattern'.
+func ListNameGlob(pattern string) ListOption {
+	return func(o *listOptions) { o.nameGlob = pattern }
+}
+
+// ListMinSize reports only entries whose SubtreeSize is at least 'size'.
+func ListMinSize(size int64) ListOption {
+	return func(o *listOptions) { o.minSize = size }
+}
+
+// ListOmitObjects strips the object and block references from the reported
+// nodes, shrinking the payload when callers only need names and sizes.
+func ListOmitObjects() ListOption {
+	return func(o *listOptions) { o.omitObjects = true }
+}
+
 // List executes a callback for each file under a directory (or a file if the path is a file).
-func (h *dbHashTree) List(path string, f func(*NodeProto) error) error {
+func (h *dbHashTree) List(path string, f func(*NodeProto) error, opts ...ListOption) error {
+	trace("list", path)
 	path = clean(path)
+	o := &listOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	var g *globlib.Glob
+	if o.nameGlob != "" {
+		var err error
+		if g, err = compileGlob(o.nameGlob); err != nil {
+			return err
+		}
+	}
 	return h.View(func(tx *bolt.Tx) error {
-		return list(tx, path, f)
+		return list(tx, path, func(node *NodeProto) error {
+			if o.filesOnly && node.nodetype() == directory {
+				return nil
+			}
+			if o.dirsOnly && node.nodetype() != directory {
+				return nil
+			}
+			if node.SubtreeSize < o.minSize {
+				return nil
+			}
+			if g != nil && !g.Match(node.Name) {
+				return nil
+			}
+			if o.omitObjects && node.FileNode != nil {
+				// each node is unmarshalled fresh for this call, so stripping
+				// the references doesn't touch the stored node
+				node.FileNode.Objects = nil
+				node.FileNode.BlockRefs = nil
+			}
+			return f(node)
+		})
 	})
 }
 
 // ListAll retrieves all the files under a directory (or a file if the path is a file).
-func (h *dbHashTree) ListAll(path string) ([]*NodeProto, error) {
+func (h *dbHashTree) ListAll(path string, opts ...ListOption) ([]*NodeProto, error) {
 	var result []*NodeProto
 	if err := h.List(path, func(node *NodeProto) error {
 		result = append(result, node)
 		return nil
-	}); err != nil {
+	}, opts...); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -253,9 +400,9 @@ func List(rs []io.ReadCloser, pattern string, f func(string, *NodeProto) error)
 	if pattern == "" {
 		pattern = "/"
 	}
-	g, err := globlib.Compile(pattern, '/')
+	g, err := compileGlob(pattern)
 	if err != nil {
-		return errorf(MalformedGlob, err.Error())
+		return err
 	}
 	return nodes(rs, func(path string, node *NodeProto) error {
 		if (g.Match(path) && node.DirNode == nil) || (g.Match(pathlib.Dir(path))) {
@@ -274,12 +421,16 @@ func glob(tx *bolt.Tx, pattern string, f func(string, *NodeProto) error) error {
 		return f(externalDefault(pattern), node)
 	}
 
-	g, err := globlib.Compile(pattern, '/')
+	g, err := compileGlob(pattern)
 	if err != nil {
-		return errorf(MalformedGlob, err.Error())
+		return err
 	}
+	// only keys under the pattern's literal prefix can match, and they're
+	// contiguous in the (sorted) fs bucket, so seek there and stop at the
+	// first key past the prefix instead of scanning the whole tree
+	prefix := b(GlobLiteralPrefix(pattern))
 	c := fs(tx).Cursor()
-	for k, v := c.First(); k != nil; k, v = c.Next() {
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
 		if g.Match(s(k)) {
 			node := &NodeProto{}
 			if node.Unmarshal(v); err != nil {
@@ -298,18 +449,110 @@ func glob(tx *bolt.Tx, pattern string, f func(string, *NodeProto) error) error {
 
 // Glob executes a callback for each path that matches the glob pattern.
 func (h *dbHashTree) Glob(pattern string, f func(string, *NodeProto) error) error {
+	trace("glob", pattern)
 	pattern = clean(pattern)
 	return h.View(func(tx *bolt.Tx) error {
 		return glob(tx, pattern, f)
 	})
 }
 
+// GlobExclude is like Glob, but skips any path that also matches one of the
+// glob patterns in 'excludes'. The exclusions are evaluated during Glob's
+// single pass over the tree, so pipelines can express filters like "/data/**
+// but not **/*.tmp" without post-filtering the matches.
+func (h *dbHashTree) GlobExclude(pattern string, excludes []string, f func(string, *NodeProto) error) error {
+	pattern = clean(pattern)
+	excl := make([]*globlib.Glob, 0, len(excludes))
+	for _, e := range excludes {
+		g, err := compileGlob(clean(e))
+		if err != nil {
+			return err
+		}
+		excl = append(excl, g)
+	}
+	return h.View(func(tx *bolt.Tx) error {
+		return glob(tx, pattern, func(path string, node *NodeProto) error {
+			for _, g := range excl {
+				if g.Match(clean(path)) {
+					return nil
+				}
+			}
+			return f(path, node)
+		})
+	})
+}
+
+// GlobPaged is like Glob, but pages through the matches: it resumes at the
+// first path after 'start' (if non-empty) and stops after 'limit' matches (if
+// limit > 0), returning the last path it emitted so a subsequent call can pass
+// it back as 'start'. A "" result means the matches are exhausted. Paths are
+// emitted in the same deterministic (stored-key) order as Glob, so a caller
+// can page through a glob on a million-file tree without materializing every
+// match at once.
+func (h *dbHashTree) GlobPaged(pattern, start string, limit int64, f func(string, *NodeProto) error) (next string, retErr error) {
+	pattern = clean(pattern)
+	retErr = h.View(func(tx *bolt.Tx) error {
+		if !IsGlob(pattern) {
+			if start != "" {
+				return nil // the single match was already returned
+			}
+			node, err := get(tx, pattern)
+			if err != nil {
+				return err
+			}
+			return f(externalDefault(pattern), node)
+		}
+		g, err := compileGlob(pattern)
+		if err != nil {
+			return err
+		}
+		// as in glob, only scan the keys under the pattern's literal prefix
+		prefix := b(GlobLiteralPrefix(pattern))
+		c := fs(tx).Cursor()
+		var k, v []byte
+		if start == "" {
+			k, v = c.Seek(prefix)
+		} else {
+			k, v = c.Seek(b(clean(start)))
+			if k != nil && bytes.Equal(k, b(clean(start))) {
+				k, v = c.Next()
+			}
+		}
+		var matched int64
+		for ; k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			if !g.Match(s(k)) {
+				continue
+			}
+			node := &NodeProto{}
+			if err := node.Unmarshal(v); err != nil {
+				return err
+			}
+			if err := f(externalDefault(s(k)), node); err != nil {
+				if err == errutil.ErrBreak {
+					return nil
+				}
+				return err
+			}
+			matched++
+			if limit > 0 && matched >= limit {
+				next = externalDefault(s(k))
+				return nil
+			}
+		}
+		return nil
+	})
+	if retErr != nil {
+		return "", retErr
+	}
+	return next, nil
+}
+
 // Glob executes a callback for each path that matches the glob pattern.
 func Glob(rs []io.ReadCloser, pattern string, f func(string, *NodeProto) error) (retErr error) {
 	pattern = clean(pattern)
-	g, err := globlib.Compile(pattern, '/')
+	g, err := compileGlob(pattern)
 	if err != nil {
-		return errorf(MalformedGlob, err.Error())
+		return err
 	}
 	return nodes(rs, func(path string, node *NodeProto) error {
 		if g.Match(path) {
@@ -319,6 +562,38 @@ func Glob(rs []io.ReadCloser, pattern string, f func(string, *NodeProto) error)
 	})
 }
 
+// GlobStat summarizes the glob matches under one top-level directory: how
+// many paths matched there and how many bytes they account for.
+type GlobStat struct {
+	Matches   int64
+	SizeBytes int64
+}
+
+// GlobGrouped runs 'pattern' and returns the matches grouped by their first
+// path component (a match of the root itself groups under "/"), with a match
+// count and total size per group. PPS uses the groups to compute datum
+// partitioning without re-walking the match list.
+func (h *dbHashTree) GlobGrouped(pattern string) (map[string]*GlobStat, error) {
+	result := make(map[string]*GlobStat)
+	if err := h.Glob(pattern, func(path string, node *NodeProto) error {
+		topLevel := "/"
+		if first := strings.SplitN(strings.TrimPrefix(clean(path), "/"), "/", 2)[0]; first != "" {
+			topLevel = "/" + first
+		}
+		stat := result[topLevel]
+		if stat == nil {
+			stat = &GlobStat{}
+			result[topLevel] = stat
+		}
+		stat.Matches++
+		stat.SizeBytes += node.SubtreeSize
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // FSSize gets the size of the hashtree
 func (h *dbHashTree) FSSize() int64 {
 	rootNode, err := h.Get("/")
@@ -328,12 +603,89 @@ func (h *dbHashTree) FSSize() int64 {
 	return rootNode.SubtreeSize
 }
 
-// Walk executes a callback against every node in the subtree of path.
+// ErrSkipDir can be returned by a Walk callback on a directory to skip that
+// directory's descendants (analogous to filepath.SkipDir); the walk continues
+// with the directory's siblings. Returning it on a non-directory node is a
+// no-op.
+var ErrSkipDir = errors.New("skip this directory")
+
+// Iterator walks the node at a path and everything under it in sorted-path
+// order, pull-style: callers ask for the next node with Next instead of
+// receiving callbacks, so gRPC streaming handlers can apply backpressure
+// rather than buffering a whole Walk. The iterator holds a read transaction
+// open, so Close must always be called.
+type Iterator struct {
+	tx    *bolt.Tx
+	c     *bolt.Cursor
+	k, v  []byte
+	exact []byte
+	under []byte
+}
+
+// NewIterator returns an iterator over the node at 'path' and everything
+// under it. Next returns io.EOF once the subtree is exhausted.
+func (h *dbHashTree) NewIterator(path string) (*Iterator, error) {
+	path = clean(path)
+	tx, err := h.Begin(false)
+	if err != nil {
+		return nil, err
+	}
+	it := &Iterator{
+		tx: tx,
+		c:  fs(tx).Cursor(),
+	}
+	if path != "" {
+		it.exact = b(path)
+		it.under = append(append([]byte{}, it.exact...), nullByte...)
+	}
+	it.k, it.v = it.c.Seek(b(path))
+	return it, nil
+}
+
+// Next returns the next path and node, or io.EOF once the subtree is
+// exhausted.
+func (it *Iterator) Next() (string, *NodeProto, error) {
+	if it.tx == nil {
+		return "", nil, io.EOF
+	}
+	for it.k != nil {
+		k, v := it.k, it.v
+		// Keys under a path are contiguous, so the first key that's neither
+		// the path itself nor under it ends the iteration (the root contains
+		// every key, so it has no bound)
+		if it.exact != nil && !bytes.Equal(k, it.exact) && !bytes.HasPrefix(k, it.under) {
+			break
+		}
+		it.k, it.v = it.c.Next()
+		node := &NodeProto{}
+		if err := node.Unmarshal(v); err != nil {
+			return "", nil, err
+		}
+		return externalDefault(s(k)), node, nil
+	}
+	it.k, it.v = nil, nil
+	return "", nil, io.EOF
+}
+
+// Close releases the iterator's read transaction. It's safe to call more
+// than once.
+func (it *Iterator) Close() error {
+	if it.tx == nil {
+		return nil
+	}
+	tx := it.tx
+	it.tx = nil
+	return tx.Rollback()
+}
+
+// Walk executes a callback against every node in the subtree of path, in
+// sorted key order (parents before children).
 func (h *dbHashTree) Walk(path string, f func(path string, node *NodeProto) error) error {
 	path = clean(path)
 	return h.View(func(tx *bolt.Tx) error {
 		c := fs(tx).Cursor()
-		for k, v := c.Seek(b(path)); k != nil && strings.HasPrefix(s(k), path); k, v = c.Next() {
+		k, v := c.Seek(b(path))
+		for k != nil && strings.HasPrefix(s(k), path) {
 			node := &NodeProto{}
 			if err := node.Unmarshal(v); err != nil {
 				return err
@@ -344,14 +696,25 @@ func (h *dbHashTree) Walk(path string, f func(path string, node *NodeProto) erro
 			}
 			if nodePath != path && !strings.HasPrefix(nodePath, path+"/") {
 				// node is a sibling of path, and thus doesn't get walked
+				k, v = c.Next()
 				continue
 			}
 			if err := f(nodePath, node); err != nil {
+				if err == ErrSkipDir && node.nodetype() == directory {
+					// jump to the first key past the directory's descendants
+					// (which all have the directory's key plus a null byte as
+					// a prefix)
+					k, v = c.Seek(append(b(clean(nodePath)), 1))
+					continue
+				}
 				if err == errutil.ErrBreak {
 					return nil
 				}
-				return err
+				if err != ErrSkipDir {
+					return err
+				}
 			}
+			k, v = c.Next()
 		}
 		return nil
 	})
@@ -360,6 +723,7 @@ func (h *dbHashTree) Walk(path string, f func(path string, node *NodeProto) erro
 // Walk executes a callback against every node in the subtree of path.
 func Walk(rs []io.ReadCloser, walkPath string, f func(path string, node *NodeProto) error) error {
 	walkPath = clean(walkPath)
+	var skip string
 	return nodes(rs, func(path string, node *NodeProto) error {
 		if path == "" {
 			path = "/"
@@ -367,7 +731,16 @@ func Walk(rs []io.ReadCloser, walkPath string, f func(path string, node *NodePro
 		if path != walkPath && !strings.HasPrefix(path, walkPath+"/") {
 			return nil
 		}
+		if skip != "" && strings.HasPrefix(path, skip+"/") {
+			return nil // under a directory the callback asked to skip
+		}
 		if err := f(path, node); err != nil {
+			if err == ErrSkipDir {
+				if node.nodetype() == directory {
+					skip = path
+				}
+				return nil
+			}
 			if err == errutil.ErrBreak {
 				return nil
 			}
@@ -498,89 +871,567 @@ func (h *dbHashTree) Diff(oldHashTree HashTree, newPath string, oldPath string,
 	return diff(newTx, oldTx, newPath, oldPath, recursiveDepth, f)
 }
 
-// Serialize serializes a binary version of the hashtree.
-func (h *dbHashTree) Serialize(_w io.Writer) error {
-	w := pbutil.NewWriter(_w)
-	return h.View(func(tx *bolt.Tx) error {
-		for _, bucket := range buckets {
-			b := tx.Bucket(b(bucket))
-			if _, err := w.Write(
-				&BucketHeader{
-					Bucket: bucket,
-				}); err != nil {
-				return err
-			}
-			if err := b.ForEach(func(k, v []byte) error {
-				if _, err := w.WriteBytes(k); err != nil {
+// diffMany is the shared-traversal core of DiffMany: 'active' holds the
+// indices of the old trees that might still differ under this path. An old
+// tree whose node hash matches the new tree's drops out of the recursion
+// here, so identical subtrees are never descended into, and the new tree is
+// only walked once no matter how many old trees are being compared.
+func diffMany(newTx *bolt.Tx, oldTxs []*bolt.Tx, active []int, newPath string, oldPath string, recursiveDepth int64, f func(oldIndex int, path string, node *NodeProto, new bool) error) error {
+	newNode, err := get(newTx, clean(newPath))
+	if err != nil && Code(err) != PathNotFound {
+		return err
+	}
+	oldNodes := make([]*NodeProto, len(oldTxs))
+	var still []int
+	for _, i := range active {
+		oldNode, err := get(oldTxs[i], clean(oldPath))
+		if err != nil && Code(err) != PathNotFound {
+			return err
+		}
+		if (newNode == nil && oldNode == nil) ||
+			(newNode != nil && oldNode != nil && bytes.Equal(newNode.Hash, oldNode.Hash)) {
+			continue
+		}
+		oldNodes[i] = oldNode
+		still = append(still, i)
+	}
+	if len(still) == 0 {
+		return nil
+	}
+	recurse := recursiveDepth > 0 || recursiveDepth == -1
+	// children is the union of child names across the new tree and the old
+	// trees that still differ
+	children := make(map[string]bool)
+	if newNode != nil {
+		if newNode.FileNode != nil || recursiveDepth == 0 {
+			for _, i := range still {
+				if err := f(i, newPath, newNode, true); err != nil {
 					return err
 				}
-				_, err := w.WriteBytes(v)
-				return err
-			}); err != nil {
-				return err
 			}
-			if _, err := w.WriteBytes(SentinelByte); err != nil {
+		} else if newNode.DirNode != nil && recurse {
+			dc := NewChildCursor(newTx, newPath)
+			for k := dc.K(); k != nil; k, _ = dc.Next() {
+				children[pathlib.Base(s(k))] = true
+			}
+		}
+	}
+	for _, i := range still {
+		oldNode := oldNodes[i]
+		if oldNode == nil {
+			continue
+		}
+		if oldNode.FileNode != nil || recursiveDepth == 0 {
+			if err := f(i, oldPath, oldNode, false); err != nil {
 				return err
 			}
+		} else if oldNode.DirNode != nil && recurse {
+			dc := NewChildCursor(oldTxs[i], oldPath)
+			for k := dc.K(); k != nil; k, _ = dc.Next() {
+				children[pathlib.Base(s(k))] = true
+			}
 		}
+	}
+	if !recurse || len(children) == 0 {
 		return nil
-	})
+	}
+	newDepth := recursiveDepth
+	if recursiveDepth > 0 {
+		newDepth--
+	}
+	names := make([]string, 0, len(children))
+	for name := range children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, child := range names {
+		if err := diffMany(newTx, oldTxs, still, pathlib.Join(newPath, child), pathlib.Join(oldPath, child), newDepth, f); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-type keyValue struct {
-	k, v []byte
+// DiffMany is like Diff, but compares 'h' against several old hashtrees in
+// one traversal: f is called with the index (into 'oldHashTrees') of the old
+// tree the reported path differs from, followed by Diff's usual arguments.
+// Subtrees whose hashes match drop out of the walk per old tree, so 'h' is
+// read once rather than once per comparison.
+func (h *dbHashTree) DiffMany(oldHashTrees []HashTree, newPath string, oldPath string, recursiveDepth int64, f func(oldIndex int, path string, node *NodeProto, new bool) error) (retErr error) {
+	// Open one read txn per distinct tree (a tree may appear several times,
+	// and may be 'h' itself; two read txs to the same tree could deadlock
+	// against a concurrent write tx)
+	txs := make(map[*dbHashTree]*bolt.Tx)
+	defer func() {
+		for _, tx := range txs {
+			if err := tx.Rollback(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}
+	}()
+	begin := func(t *dbHashTree) (*bolt.Tx, error) {
+		if tx, ok := txs[t]; ok {
+			return tx, nil
+		}
+		tx, err := t.Begin(false)
+		if err != nil {
+			return nil, err
+		}
+		txs[t] = tx
+		return tx, nil
+	}
+	newTx, err := begin(h)
+	if err != nil {
+		return err
+	}
+	oldTxs := make([]*bolt.Tx, len(oldHashTrees))
+	active := make([]int, 0, len(oldHashTrees))
+	for i, o := range oldHashTrees {
+		old, ok := o.(*dbHashTree)
+		if !ok {
+			return fmt.Errorf("unrecognized HashTree type")
+		}
+		if oldTxs[i], err = begin(old); err != nil {
+			return err
+		}
+		active = append(active, i)
+	}
+	return diffMany(newTx, oldTxs, active, newPath, oldPath, recursiveDepth, f)
 }
 
-// Deserialize deserializes a hashtree.
-func (h *dbHashTree) Deserialize(_r io.Reader) error {
-	r := pbutil.NewReader(_r)
-	hdr := &BucketHeader{}
-	batchSize := 10000
-
-	kvs := make(chan *keyValue, batchSize/10)
-	// create cancellable ctx in case bolt writer encounters error
-	eg, copyCtx := errgroup.WithContext(context.Background())
-	eg.Go(func() error {
-		var bucket []byte
-		for {
-			count := 0
-			if err := h.Update(func(tx *bolt.Tx) error {
-				if bucket != nil {
-					tx.Bucket(bucket).FillPercent = 1
-				}
-				for kv := range kvs {
-					if kv.k == nil {
-						bucket = kv.v
-						continue
-					}
-					if err := tx.Bucket(bucket).Put(kv.k, kv.v); err != nil {
-						return err
-					}
-					count++
-					if count >= batchSize {
-						return nil
-					}
-				}
-				return nil
-			}); err != nil || copyCtx.Err() != nil {
-				return err // may return nil if copyCtx was closed
-			}
-			if count <= 0 {
-				return nil
-			}
+// DiffRenames is like Diff, but matches deleted files against added files
+// with identical content hashes and reports each matched pair as a rename: f
+// is called once for the pair, with the added path and node (new == true) and
+// the deleted path in 'renamedFrom'. Additions, deletions, and modifications
+// that aren't part of a rename are reported with renamedFrom == "". Unlike
+// Diff, this buffers the diff (not the trees) in memory to do the matching.
+func (h *dbHashTree) DiffRenames(oldHashTree HashTree, newPath string, oldPath string, recursiveDepth int64, f func(path string, node *NodeProto, new bool, renamedFrom string) error) error {
+	type diffEntry struct {
+		path string
+		node *NodeProto
+		new  bool
+	}
+	var entries []*diffEntry
+	deleted := make(map[string][]*diffEntry) // deleted file entries by hash
+	if err := h.Diff(oldHashTree, newPath, oldPath, recursiveDepth, func(path string, node *NodeProto, new bool) error {
+		e := &diffEntry{path: path, node: node, new: new}
+		entries = append(entries, e)
+		if !new && node.nodetype() == file && len(node.Hash) > 0 {
+			hash := string(node.Hash)
+			deleted[hash] = append(deleted[hash], e)
 		}
-	})
-	eg.Go(func() error {
-		defer close(kvs)
-		for {
-			hdr.Reset()
-			// TODO(msteffen): don't block on Read if copyCtx() is cancelled?
-			if err := r.Read(hdr); err != nil {
-				if err == io.EOF {
-					break
-				}
+		return nil
+	}); err != nil {
+		return err
+	}
+	// pair each added file with the first unmatched deleted file that has the
+	// same content hash
+	renamedFrom := make(map[*diffEntry]string)
+	matched := make(map[*diffEntry]bool)
+	for _, e := range entries {
+		if !e.new || e.node.nodetype() != file || len(e.node.Hash) == 0 {
+			continue
+		}
+		hash := string(e.node.Hash)
+		if len(deleted[hash]) > 0 {
+			from := deleted[hash][0]
+			deleted[hash] = deleted[hash][1:]
+			renamedFrom[e] = from.path
+			matched[from] = true
+		}
+	}
+	for _, e := range entries {
+		if matched[e] {
+			continue // reported as the renamedFrom side of an addition
+		}
+		if err := f(e.path, e.node, e.new, renamedFrom[e]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DiffStat summarizes the changes under one top-level directory: how many
+// files were added, removed, or modified there, and how many bytes those
+// changes account for.
+type DiffStat struct {
+	FilesAdded, FilesRemoved, FilesModified int64
+	BytesAdded, BytesRemoved                int64
+}
+
+// DiffStats diffs 'h' against 'oldHashTree' and returns a summary of the
+// changed files per top-level directory (files at the root itself are
+// summarized under "/"). A file present on both sides of the diff counts as
+// modified, with its size change attributed to BytesAdded or BytesRemoved
+// depending on its direction. This returns aggregate counts instead of
+// streaming a result per changed file, which is all a commit-summary display
+// needs.
+func (h *dbHashTree) DiffStats(oldHashTree HashTree, newPath string, oldPath string, recursiveDepth int64) (map[string]*DiffStat, error) {
+	// Diff reports the two sides of a modified file separately, so pair them
+	// up by path before aggregating
+	type fileDiff struct {
+		newSize, oldSize int64
+		hasNew, hasOld   bool
+	}
+	files := make(map[string]*fileDiff)
+	if err := h.Diff(oldHashTree, newPath, oldPath, recursiveDepth, func(path string, node *NodeProto, new bool) error {
+		if node.nodetype() != file {
+			return nil
+		}
+		fd := files[path]
+		if fd == nil {
+			fd = &fileDiff{}
+			files[path] = fd
+		}
+		if new {
+			fd.hasNew = true
+			fd.newSize += node.SubtreeSize
+		} else {
+			fd.hasOld = true
+			fd.oldSize += node.SubtreeSize
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	stats := make(map[string]*DiffStat)
+	for path, fd := range files {
+		topLevel := "/"
+		if parts := strings.SplitN(strings.TrimPrefix(clean(path), "/"), "/", 2); len(parts) == 2 {
+			topLevel = "/" + parts[0]
+		}
+		stat := stats[topLevel]
+		if stat == nil {
+			stat = &DiffStat{}
+			stats[topLevel] = stat
+		}
+		switch {
+		case fd.hasNew && fd.hasOld:
+			stat.FilesModified++
+			if fd.newSize > fd.oldSize {
+				stat.BytesAdded += fd.newSize - fd.oldSize
+			} else {
+				stat.BytesRemoved += fd.oldSize - fd.newSize
+			}
+		case fd.hasNew:
+			stat.FilesAdded++
+			stat.BytesAdded += fd.newSize
+		default:
+			stat.FilesRemoved++
+			stat.BytesRemoved += fd.oldSize
+		}
+	}
+	return stats, nil
+}
+
+// nodeContentEquals reports whether two nodes have the same content,
+// independent of how their hashes were computed: files compare their object
+// and block references, symlinks their targets, and directories their
+// header/footer data (directory children are compared by the surrounding
+// walk). Attributes are part of a node's content.
+func nodeContentEquals(a, b *NodeProto) bool {
+	if a.nodetype() != b.nodetype() {
+		return false
+	}
+	if len(a.Attributes) != len(b.Attributes) {
+		return false
+	}
+	for k, v := range a.Attributes {
+		if b.Attributes[k] != v {
+			return false
+		}
+	}
+	switch a.nodetype() {
+	case file:
+		af, bf := a.FileNode, b.FileNode
+		if a.SubtreeSize != b.SubtreeSize ||
+			af.HasHeaderFooter != bf.HasHeaderFooter ||
+			len(af.Objects) != len(bf.Objects) ||
+			len(af.BlockRefs) != len(bf.BlockRefs) {
+			return false
+		}
+		for i, o := range af.Objects {
+			if o.Hash != bf.Objects[i].Hash {
+				return false
+			}
+		}
+		for i, br := range af.BlockRefs {
+			if br.String() != bf.BlockRefs[i].String() {
+				return false
+			}
+		}
+	case symlink:
+		if a.SymlinkNode.Target != b.SymlinkNode.Target {
+			return false
+		}
+	case directory:
+		if a.DirNode.Shared.String() != b.DirNode.Shared.String() {
+			return false
+		}
+	}
+	return true
+}
+
+// contentEquals compares the subtrees rooted at 'path' in newTx and
+// 'otherPath' in oldTx. If 'byHash' is set (the trees hash nodes the same
+// way), subtrees with matching non-empty hashes compare equal without being
+// walked.
+func contentEquals(newTx, oldTx *bolt.Tx, path, otherPath string, byHash bool) (bool, error) {
+	path, otherPath = clean(path), clean(otherPath)
+	n, err := get(newTx, path)
+	if err != nil && Code(err) != PathNotFound {
+		return false, err
+	}
+	o, err := get(oldTx, otherPath)
+	if err != nil && Code(err) != PathNotFound {
+		return false, err
+	}
+	if n == nil || o == nil {
+		return n == nil && o == nil, nil
+	}
+	if byHash && len(n.Hash) > 0 && bytes.Equal(n.Hash, o.Hash) {
+		return true, nil
+	}
+	if !nodeContentEquals(n, o) {
+		return false, nil
+	}
+	if n.nodetype() != directory {
+		return true, nil
+	}
+	nc := NewChildCursor(newTx, path)
+	oc := NewChildCursor(oldTx, otherPath)
+	for {
+		nk, ok := nc.K(), oc.K()
+		if nk == nil && ok == nil {
+			return true, nil
+		}
+		if nk == nil || ok == nil {
+			return false, nil
+		}
+		child, otherChild := pathlib.Base(s(nk)), pathlib.Base(s(ok))
+		if child != otherChild {
+			return false, nil
+		}
+		eq, err := contentEquals(newTx, oldTx, pathlib.Join(path, child), pathlib.Join(otherPath, otherChild), byHash)
+		if err != nil || !eq {
+			return eq, err
+		}
+		nc.Next()
+		oc.Next()
+	}
+}
+
+// ContentEquals reports whether the subtree at 'path' has the same content as
+// the subtree at 'otherPath' in 'other'. When both trees hash nodes the same
+// way, subtrees with matching hashes compare equal without being walked, so
+// comparing identical (hashed) trees is O(1); elsewhere it falls back to
+// comparing nodes structurally, so trees hashed with different formats still
+// compare equal when their contents match. If neither path exists the
+// subtrees are (vacuously) equal.
+func (h *dbHashTree) ContentEquals(other HashTree, path, otherPath string) (_ bool, retErr error) {
+	o, ok := other.(*dbHashTree)
+	if !ok {
+		return false, fmt.Errorf("unrecognized HashTree type")
+	}
+	byHash := h.hasher.format == o.hasher.format && h.hasher.algorithm == o.hasher.algorithm
+	rollback := func(tx *bolt.Tx) {
+		if err := tx.Rollback(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}
+	// As in Diff, take a single read tx when both sides are the same tree, so
+	// an interleaved write tx can't deadlock us
+	newTx, err := h.Begin(false)
+	if err != nil {
+		return false, err
+	}
+	defer rollback(newTx)
+	oldTx := newTx
+	if h != o {
+		if oldTx, err = o.Begin(false); err != nil {
+			return false, err
+		}
+		defer rollback(oldTx)
+	}
+	return contentEquals(newTx, oldTx, path, otherPath, byHash)
+}
+
+// Equal reports whether two trees have the same content. It short-circuits on
+// the root hashes, so comparing two identical hashed trees doesn't walk them
+// (which is what lets PFS skip no-op commits cheaply).
+func (h *dbHashTree) Equal(other HashTree) (bool, error) {
+	return h.ContentEquals(other, "", "")
+}
+
+// compressionMagic prefixes a compressed serialized tree so readers can
+// detect it. An uncompressed stream starts with the varint length of its
+// first bucket header, which is never zero, so the leading zero byte can't
+// occur at the start of an uncompressed tree.
+var compressionMagic = []byte{0x00, 'p', 'c', 'h', 's', 'n', 'p', 'y'}
+
+// SerializeOption configures Serialize. The default writes the uncompressed
+// format.
+type SerializeOption func(*serializeOptions)
+
+type serializeOptions struct {
+	compress bool
+}
+
+// WithCompression makes Serialize snappy-compress the tree, cutting its
+// object-store footprint and transfer time. Compressed trees are
+// self-describing (see compressionMagic): Deserialize reads both formats
+// without being told which it was given.
+func WithCompression() SerializeOption {
+	return func(opts *serializeOptions) {
+		opts.compress = true
+	}
+}
+
+// Serialize serializes a binary version of the hashtree. Keys and values are
+// streamed out of the database one at a time (through a fixed-size buffer, as
+// each one is written with a tiny length prefix), so serializing a multi-GB
+// tree doesn't require materializing it in memory.
+func (h *dbHashTree) Serialize(_w io.Writer, opts ...SerializeOption) error {
+	options := &serializeOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.compress {
+		if _, err := _w.Write(compressionMagic); err != nil {
+			return err
+		}
+		sw := snappy.NewBufferedWriter(_w)
+		if err := h.serialize(sw); err != nil {
+			return err
+		}
+		return sw.Close()
+	}
+	return h.serialize(_w)
+}
+
+func (h *dbHashTree) serialize(_w io.Writer) error {
+	bw := bufio.NewWriterSize(_w, serializeBufSize)
+	w := pbutil.NewWriter(bw)
+	if err := h.View(func(tx *bolt.Tx) error {
+		for i, bucket := range buckets {
+			b := tx.Bucket(b(bucket))
+			if bucket == LinksBucket && b.Stats().KeyN == 0 {
+				// trees without hard links serialize exactly as before, so
+				// they stay readable by older versions
+				continue
+			}
+			hdr := &BucketHeader{Bucket: bucket}
+			if i == 0 && h.hasher.format != FormatV1 {
+				// v1 trees serialize without format fields, so old readers
+				// (and old trees) are unaffected
+				hdr.Format = h.hasher.format
+				hdr.HashAlgorithm = h.hasher.algorithm
+			}
+			if _, err := w.Write(hdr); err != nil {
+				return err
+			}
+			if err := b.ForEach(func(k, v []byte) error {
+				if _, err := w.WriteBytes(k); err != nil {
+					return err
+				}
+				_, err := w.WriteBytes(v)
+				return err
+			}); err != nil {
+				return err
+			}
+			if _, err := w.WriteBytes(SentinelByte); err != nil {
 				return err
 			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+type keyValue struct {
+	k, v []byte
+}
+
+// Deserialize deserializes a hashtree. Like Serialize, it streams keys and
+// values through a fixed-size buffer (batching them into bolt transactions),
+// so its memory use is bounded.
+func (h *dbHashTree) Deserialize(_r io.Reader) error {
+	br := bufio.NewReaderSize(_r, serializeBufSize)
+	// a compressed tree announces itself with a magic prefix (uncompressed
+	// trees can't start with it); peek errors are left for the reads below
+	if magic, err := br.Peek(len(compressionMagic)); err == nil && bytes.Equal(magic, compressionMagic) {
+		if _, err := br.Discard(len(compressionMagic)); err != nil {
+			return err
+		}
+		return h.deserialize(pbutil.NewReader(bufio.NewReaderSize(snappy.NewReader(br), serializeBufSize)))
+	}
+	return h.deserialize(pbutil.NewReader(br))
+}
+
+func (h *dbHashTree) deserialize(r pbutil.Reader) error {
+	hdr := &BucketHeader{}
+	batchSize := 10000
+
+	kvs := make(chan *keyValue, batchSize/10)
+	// create cancellable ctx in case bolt writer encounters error
+	eg, copyCtx := errgroup.WithContext(context.Background())
+	eg.Go(func() error {
+		var bucket []byte
+		for {
+			count := 0
+			if err := h.Update(func(tx *bolt.Tx) error {
+				if bucket != nil {
+					tx.Bucket(bucket).FillPercent = 1
+				}
+				for kv := range kvs {
+					if kv.k == nil {
+						bucket = kv.v
+						continue
+					}
+					if err := tx.Bucket(bucket).Put(kv.k, kv.v); err != nil {
+						return err
+					}
+					count++
+					if count >= batchSize {
+						return nil
+					}
+				}
+				return nil
+			}); err != nil || copyCtx.Err() != nil {
+				return err // may return nil if copyCtx was closed
+			}
+			if count <= 0 {
+				return nil
+			}
+		}
+	})
+	eg.Go(func() error {
+		defer close(kvs)
+		firstHeader := true
+		for {
+			hdr.Reset()
+			// TODO(msteffen): don't block on Read if copyCtx() is cancelled?
+			if err := r.Read(hdr); err != nil {
+				if err == io.EOF {
+					break
+				}
+				return err
+			}
+			if firstHeader {
+				// the first header records the tree's format (zero values =>
+				// v1); adopt it so this tree keeps hashing nodes the same way.
+				// A format newer than this build understands still
+				// deserializes (the nodes are copied verbatim), it just can't
+				// be rehashed
+				hasher, err := newCompatNodeHasher(hdr.Format, hdr.HashAlgorithm)
+				if err != nil {
+					return err
+				}
+				h.hasher = hasher
+				firstHeader = false
+			}
 			bucket := b(hdr.Bucket)
 			select {
 			case kvs <- &keyValue{nil, bucket}:
@@ -616,28 +1467,312 @@ func (h *dbHashTree) Deserialize(_r io.Reader) error {
 	return eg.Wait()
 }
 
-// Copy returns a copy of the hashtree.
+// Copy returns a copy of the hashtree. The copy is made at the database level
+// (bolt writes a page-level snapshot of the source file within a read
+// transaction), so it costs sequential I/O proportional to the size of the
+// database rather than re-serializing and re-inserting every node.
 func (h *dbHashTree) Copy() (HashTree, error) {
 	if err := h.Hash(); err != nil {
 		return nil, err
 	}
-	r, w := io.Pipe()
-	var eg errgroup.Group
-	eg.Go(func() (retErr error) {
-		defer func() {
-			if err := w.Close(); err != nil && retErr == nil {
-				retErr = err
+	file := dbFile(pathlib.Dir(pathlib.Dir(h.Path())))
+	if err := os.MkdirAll(pathlib.Dir(file), 0777); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.View(func(tx *bolt.Tx) error {
+		_, err := tx.WriteTo(f)
+		return err
+	}); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	result, err := newDBHashTree(file)
+	if err != nil {
+		return nil, err
+	}
+	result.hasher = h.hasher
+	return result, nil
+}
+
+// Shard partitions the tree into self-contained subtrees of roughly
+// 'targetBytes' each, split along directory boundaries: a directory (or file)
+// that fits within the target is never broken up, and directories larger than
+// the target are split along their children. Shards are packed in path order,
+// so each one covers a contiguous slice of the tree. A single file larger
+// than the target gets a shard of its own. This gives PPS balanced units of
+// work to hand to workers.
+func (h *dbHashTree) Shard(targetBytes int64) (_ []HashTree, retErr error) {
+	if targetBytes <= 0 {
+		return nil, errorf(Internal, "shard target size must be positive, not %d", targetBytes)
+	}
+	if err := h.Hash(); err != nil { // sizes and hashes must be current
+		return nil, err
+	}
+	// Collect the indivisible units: subtrees that fit within the target, or
+	// single files that don't
+	var units []shardUnit
+	if err := h.View(func(tx *bolt.Tx) error {
+		var collect func(path string) error
+		collect = func(path string) error {
+			node, err := get(tx, path)
+			if err != nil {
+				return err
 			}
-		}()
-		return h.Serialize(w)
-	})
-	var result HashTree
-	eg.Go(func() error {
-		var err error
-		result, err = DeserializeDBHashTree(pathlib.Dir(h.Path()), r)
+			if node.nodetype() != directory || node.SubtreeSize <= targetBytes {
+				units = append(units, shardUnit{path: path, size: node.SubtreeSize})
+				return nil
+			}
+			c := NewChildCursor(tx, path)
+			for k := c.K(); k != nil; k, _ = c.Next() {
+				if err := collect(s(k)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return collect("")
+	}); err != nil {
+		return nil, err
+	}
+
+	// Pack the units into shards in order
+	var shards []HashTree
+	defer func() {
+		if retErr != nil {
+			for _, shard := range shards {
+				shard.Destroy()
+			}
+		}
+	}()
+	storageRoot := pathlib.Dir(pathlib.Dir(h.Path()))
+	var batch []shardUnit
+	var batchSize int64
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		shard, err := h.buildShard(storageRoot, batch[0].path == "" && len(batch) == 1, batch)
+		if err != nil {
+			return err
+		}
+		shards = append(shards, shard)
+		batch, batchSize = nil, 0
+		return nil
+	}
+	for _, u := range units {
+		if len(batch) > 0 && batchSize+u.size > targetBytes {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+		batch = append(batch, u)
+		batchSize += u.size
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return shards, nil
+}
+
+// shardUnit is one indivisible piece of a shard: a subtree (or single file)
+// and its size.
+type shardUnit struct {
+	path string
+	size int64
+}
+
+// buildShard assembles one shard: each unit's subtree is copied verbatim, and
+// the units' ancestor directories are recreated (with sizes covering only the
+// shard's contents) and rehashed.
+func (h *dbHashTree) buildShard(storageRoot string, wholeTree bool, batch []shardUnit) (HashTree, error) {
+	if wholeTree {
+		return h.Copy()
+	}
+	result, err := NewDBHashTreeFormat(storageRoot, h.hasher.format, h.hasher.algorithm)
+	if err != nil {
+		return nil, err
+	}
+	t := result.(*dbHashTree)
+	if err := h.View(func(tx *bolt.Tx) error {
+		return t.Update(func(dst *bolt.Tx) error {
+			for _, u := range batch {
+				// Copy the unit's node and everything under it verbatim
+				// (their hashes and sizes are already canonical)
+				v := fs(tx).Get(b(u.path))
+				if err := fs(dst).Put(b(u.path), append([]byte{}, v...)); err != nil {
+					return err
+				}
+				prefix := append(b(u.path), nullByte...)
+				c := fs(tx).Cursor()
+				for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+					newK := make([]byte, len(k))
+					copy(newK, k)
+					newV := make([]byte, len(v))
+					copy(newV, v)
+					if err := fs(dst).Put(newK, newV); err != nil {
+						return err
+					}
+				}
+				// Recreate the unit's ancestors; visit marks them changed, so
+				// Hash recomputes their hashes over the shard's children
+				if err := visit(dst, u.path, func(node *NodeProto, parent, child string) error {
+					if node.DirNode == nil {
+						node.Name = base(parent)
+						node.DirNode = &DirectoryNodeProto{}
+					}
+					node.SubtreeSize += u.size
+					return nil
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}); err != nil {
+		result.Destroy()
+		return nil, err
+	}
+	if err := result.Hash(); err != nil {
+		result.Destroy()
+		return nil, err
+	}
+	return result, nil
+}
+
+// Checkpoint writes a consistent snapshot of the tree to a file next to its
+// database and returns a token identifying it. Like Copy, the snapshot is
+// made at the database level (a page-level copy within a read transaction),
+// so it costs sequential I/O proportional to the size of the database. A
+// worker can checkpoint the shared tree before processing a datum and, if the
+// datum fails, Revert to discard its writes without rebuilding the tree.
+func (h *dbHashTree) Checkpoint() (string, error) {
+	checkpoint := fmt.Sprintf("%s-checkpoint-%s", h.Path(), uuid.NewWithoutDashes())
+	f, err := os.OpenFile(checkpoint, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return "", err
+	}
+	if err := h.View(func(tx *bolt.Tx) error {
+		_, err := tx.WriteTo(f)
 		return err
-	})
-	if err := eg.Wait(); err != nil {
+	}); err != nil {
+		f.Close()
+		os.Remove(checkpoint)
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(checkpoint)
+		return "", err
+	}
+	h.checkpoints = append(h.checkpoints, checkpoint)
+	return checkpoint, nil
+}
+
+// Revert discards every write made since 'checkpoint' was taken, restoring
+// the tree to that snapshot. The checkpoint remains valid afterwards, so a
+// retry loop can revert to it repeatedly. Revert reopens the underlying
+// database and must not run concurrently with other operations on the tree.
+func (h *dbHashTree) Revert(checkpoint string) error {
+	src, err := os.Open(checkpoint)
+	if err != nil {
+		return errorf(Unknown, "cannot revert to checkpoint %q: %v", checkpoint, err)
+	}
+	defer src.Close()
+	file := h.Path()
+	if err := h.DB.Close(); err != nil {
+		return err
+	}
+	dst, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	reopened, err := newDBHashTree(file)
+	if err != nil {
+		return err
+	}
+	h.DB = reopened.DB
+	return nil
+}
+
+// DropCheckpoint deletes a checkpoint taken with Checkpoint, releasing its
+// disk space. Destroy removes any remaining checkpoints, so dropping them
+// early is an optimization, not a requirement.
+func (h *dbHashTree) DropCheckpoint(checkpoint string) error {
+	for i, c := range h.checkpoints {
+		if c == checkpoint {
+			h.checkpoints = append(h.checkpoints[:i], h.checkpoints[i+1:]...)
+			break
+		}
+	}
+	return os.Remove(checkpoint)
+}
+
+// Subtree returns a new, self-contained hashtree rooted at the directory at
+// 'path': that directory becomes the new tree's root and its descendants keep
+// their paths relative to it. Hashes and sizes are preserved (a node's hash
+// doesn't cover its own name), so the result can be serialized, copied, or
+// merged like any other tree.
+func (h *dbHashTree) Subtree(path string) (_ HashTree, retErr error) {
+	path = clean(path)
+	if path == "" {
+		return h.Copy()
+	}
+	if err := h.Hash(); err != nil {
+		return nil, err
+	}
+	result, err := NewDBHashTree(pathlib.Dir(pathlib.Dir(h.Path())))
+	if err != nil {
+		return nil, err
+	}
+	result.(*dbHashTree).hasher = h.hasher
+	defer func() {
+		if retErr != nil {
+			result.Destroy()
+		}
+	}()
+	if err := h.View(func(tx *bolt.Tx) error {
+		node, err := get(tx, path)
+		if err != nil {
+			return err
+		}
+		if node.nodetype() != directory {
+			return errorf(PathConflict, "the file at \"%s\" is not a directory", path)
+		}
+		root := *node
+		root.Name = ""
+		rootData, err := root.Marshal()
+		if err != nil {
+			return err
+		}
+		return result.(*dbHashTree).Update(func(resultTx *bolt.Tx) error {
+			if err := fs(resultTx).Put(b(""), rootData); err != nil {
+				return err
+			}
+			// every descendant of 'path' keeps its node unchanged under a
+			// re-rooted key
+			prefix := append(b(path), nullByte...)
+			c := fs(tx).Cursor()
+			for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+				if err := fs(resultTx).Put(k[len(b(path)):], v); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -649,6 +1784,9 @@ func (h *dbHashTree) Destroy() error {
 	if err := h.Close(); err != nil {
 		return err
 	}
+	for _, checkpoint := range h.checkpoints {
+		os.Remove(checkpoint) // best-effort; checkpoints live in tmp storage
+	}
 	return os.Remove(path)
 }
 
@@ -711,6 +1849,79 @@ func (h *dbHashTree) PutFileOverwrite(path string, objects []*pfs.Object, overwr
 	return h.putFile(path, objects, overwriteIndex, sizeDelta, false)
 }
 
+// PutFileOp describes one file insertion for PutFiles.
+type PutFileOp struct {
+	Path    string
+	Objects []*pfs.Object
+	Size    int64
+}
+
+// PutFiles applies many file insertions in a single transaction. Ancestor
+// updates are deferred and aggregated, so each ancestor directory is read and
+// written once per batch instead of once per file; with thousands of files
+// per directory that avoids most of the O(files * depth) repeated work of
+// calling PutFile in a loop.
+func (h *dbHashTree) PutFiles(ops []PutFileOp) error {
+	return h.Batch(func(tx *bolt.Tx) error {
+		deltas := make(map[string]int64)
+		for _, op := range ops {
+			path := clean(op.Path)
+			node, err := get(tx, path)
+			if err != nil && Code(err) != PathNotFound {
+				return errorf(Internal, "could not get node at %q: %v", path, err)
+			}
+			if node != nil && node.nodetype() != file {
+				return errorf(PathConflict, "could not put file at %q; a file of "+
+					"type %s is already there", path, node.nodetype())
+			}
+			if node == nil {
+				node = &NodeProto{
+					Name:     base(path),
+					FileNode: &FileNodeProto{},
+				}
+			}
+			node.SubtreeSize += op.Size
+			node.FileNode.Objects = append(node.FileNode.Objects, op.Objects...)
+			if err := put(tx, path, node); err != nil {
+				return err
+			}
+			// Writes to a hard-linked path apply to the whole link group
+			if err := propagateLinks(tx, path, node, op.Size); err != nil {
+				return err
+			}
+			for p := path; p != ""; {
+				parent, _ := split(p)
+				deltas[parent] += op.Size
+				p = parent
+			}
+		}
+		// Apply the aggregated ancestor updates, creating intermediate
+		// directories as needed (every intermediate directory is itself an
+		// ancestor of some op, so it has an entry in 'deltas')
+		for path, delta := range deltas {
+			node, err := get(tx, path)
+			if err != nil && Code(err) != PathNotFound {
+				return err
+			}
+			if node != nil && node.nodetype() != directory {
+				return errorf(PathConflict, "could not put files under %q; a "+
+					"file of type %s is already there", path, node.nodetype())
+			}
+			if node == nil {
+				node = &NodeProto{
+					Name:    base(path),
+					DirNode: &DirectoryNodeProto{},
+				}
+			}
+			node.SubtreeSize += delta
+			if err := put(tx, path, node); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // PutDirHeaderFooter implements the hashtree.PutDirHeaderFooter interface
 // method
 func (h *dbHashTree) PutDirHeaderFooter(path string, header, footer *pfs.Object, headerSize, footerSize int64) error {
@@ -757,8 +1968,57 @@ func (h *dbHashTree) PutDirHeaderFooter(path string, header, footer *pfs.Object,
 			}
 			return put(tx, path, node)
 		}
-		return nil
-	})
+		return nil
+	})
+}
+
+// sharedAncestor returns the path and Shared data of the nearest ancestor
+// directory of 'path' with headers/footers attached. Ancestors that don't
+// exist yet are skipped (they'll be created when the file is put), but an
+// ancestor that exists and isn't a directory is a PathConflict; if no
+// ancestor has Shared data the result is a HeaderFooterConflict.
+func sharedAncestor(tx *bolt.Tx, path string) (string, *Shared, error) {
+	parent, _ := split(path)
+	for {
+		node, err := get(tx, parent)
+		if err != nil && Code(err) != PathNotFound {
+			return "", nil, errorf(Internal, "could not get ancestor path %q: %v", parent, err)
+		}
+		if node != nil {
+			if node.nodetype() != directory {
+				return "", nil, errorf(PathConflict, "could not put regular file at %q; a non-"+
+					"directory file of type %s is already at ancestor path %q", path,
+					node.nodetype(), parent)
+			}
+			if node.DirNode.Shared != nil {
+				return parent, node.DirNode.Shared, nil
+			}
+		}
+		if parent == "" {
+			break
+		}
+		parent, _ = split(parent)
+	}
+	return "", nil, errorf(HeaderFooterConflict, "no ancestor directory of %q "+
+		"has header/footer data; initialize one with PutDirHeaderFooter before "+
+		"adding children with PutFileHeaderFooter", path)
+}
+
+// SharedAncestor returns the path and Shared data of the nearest ancestor
+// directory of 'path' that has headers/footers attached (which, for nested
+// split formats, may be above the immediate parent).
+func (h *dbHashTree) SharedAncestor(path string) (string, *Shared, error) {
+	path = clean(path)
+	var resultPath string
+	var resultShared *Shared
+	if err := h.View(func(tx *bolt.Tx) error {
+		var err error
+		resultPath, resultShared, err = sharedAncestor(tx, path)
+		return err
+	}); err != nil {
+		return "", nil, err
+	}
+	return resultPath, resultShared, nil
 }
 
 // PutFileHeaderFooter implements the HashTree PutFileHeaderFooter method
@@ -768,6 +2028,7 @@ func (h *dbHashTree) PutFileHeaderFooter(path string, objects []*pfs.Object, siz
 
 func (h *dbHashTree) putFile(path string, objects []*pfs.Object,
 	overwriteIndex *pfs.OverwriteIndex, sizeDelta int64, hasHeaderFooter bool) error {
+	trace("putFile", path)
 	path = clean(path)
 	return h.Batch(func(tx *bolt.Tx) error {
 		// validation: 'path' must point to file
@@ -780,27 +2041,14 @@ func (h *dbHashTree) putFile(path string, objects []*pfs.Object,
 				"type %s is already there", path, node.nodetype())
 		}
 
-		// validation: 'hasHeaderFooter' can be set only if parent dir has 'Shared'
-		// field for header and footer data (indicating other children of this dir
-		// have headers too--can't mix header and non-header files)
+		// validation: 'hasHeaderFooter' can be set only if some ancestor dir has
+		// a 'Shared' field for header and footer data (indicating other files
+		// under it have headers too--can't mix header and non-header files). The
+		// ancestor needn't be the immediate parent, so nested split formats can
+		// attach headers at any directory level.
 		if hasHeaderFooter {
-			parentPath, _ := split(path)
-			parent, err := get(tx, parentPath)
-			if err != nil {
-				// Note that ErrNotFound gets returned here too--you must create the
-				// parent directory with PutDirHeaderFooter before adding children with
-				// PutFileHeaderFooter
-				return errorf(Internal, "could not get parent path %q: %v", parentPath, err)
-			}
-			if parent.nodetype() != directory {
-				return errorf(PathConflict, "could not put regular file at %q; a non-"+
-					"directory file of type %s is already at parent path %q", path,
-					parent.nodetype(), parentPath)
-			}
-			if parent.DirNode.Shared == nil {
-				return errorf(HeaderFooterConflict, "could not put header/footer in "+
-					"directory at %q as it was not initialized with a header/footer, "+
-					"and headers/footers cannot be added after creation", parentPath)
+			if _, _, err := sharedAncestor(tx, path); err != nil {
+				return err
 			}
 		}
 
@@ -824,7 +2072,7 @@ func (h *dbHashTree) putFile(path string, objects []*pfs.Object,
 		if err := put(tx, path, node); err != nil {
 			return err
 		}
-		return visit(tx, path, func(node *NodeProto, parent, child string) error {
+		if err := visit(tx, path, func(node *NodeProto, parent, child string) error {
 			if node.DirNode == nil {
 				// node created as part of this visit call, fill in the basics
 				node.Name = base(parent)
@@ -832,10 +2080,61 @@ func (h *dbHashTree) putFile(path string, objects []*pfs.Object,
 			}
 			node.SubtreeSize += sizeDelta
 			return nil
-		})
+		}); err != nil {
+			return err
+		}
+		// Writes to a hard-linked path apply to the whole link group
+		return propagateLinks(tx, path, node, sizeDelta)
 	})
 }
 
+// linkMembers returns the other paths in 'path's hard-link group (not 'path'
+// itself), or nil if 'path' isn't hard-linked.
+func linkMembers(tx *bolt.Tx, path string) ([]string, error) {
+	group := links(tx).Get(b(path))
+	if group == nil {
+		return nil, nil
+	}
+	var members []string
+	if err := links(tx).ForEach(func(k, v []byte) error {
+		if bytes.Equal(v, group) && !bytes.Equal(k, b(path)) {
+			members = append(members, s(k))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// propagateLinks copies the (just-updated) file node at 'path' to every other
+// member of its hard-link group and updates their ancestors' sizes, so
+// hard-linked paths stay identical.
+func propagateLinks(tx *bolt.Tx, path string, node *NodeProto, sizeDelta int64) error {
+	members, err := linkMembers(tx, path)
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		linked := *node
+		linked.Name = base(member)
+		if err := put(tx, member, &linked); err != nil {
+			return err
+		}
+		if err := visit(tx, member, func(node *NodeProto, parent, child string) error {
+			if node.DirNode == nil {
+				node.Name = base(parent)
+				node.DirNode = &DirectoryNodeProto{}
+			}
+			node.SubtreeSize += sizeDelta
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // PutDir creates a directory (or does nothing if one exists).
 func (h *dbHashTree) PutDir(path string) error {
 	path = clean(path)
@@ -870,6 +2169,180 @@ func (h *dbHashTree) PutDir(path string) error {
 	})
 }
 
+// PutSymlink creates a symbolic link at 'path' pointing to 'target'. The
+// target is stored verbatim and may dangle, as on a filesystem; it's resolved
+// (with cycle protection) when the link is read through Get.
+func (h *dbHashTree) PutSymlink(path string, target string) error {
+	path = clean(path)
+	return h.Batch(func(tx *bolt.Tx) error {
+		node, err := get(tx, path)
+		if err != nil && Code(err) != PathNotFound {
+			return err
+		}
+		if node != nil && node.nodetype() != none && node.nodetype() != symlink {
+			return errorf(PathConflict, "could not create symlink at %q; a "+
+				"file of type %s is already there", path, node.nodetype())
+		}
+		node = &NodeProto{
+			Name:        base(path),
+			SymlinkNode: &SymlinkNodeProto{Target: target},
+		}
+		if err := put(tx, path, node); err != nil {
+			return err
+		}
+		return visit(tx, path, func(node *NodeProto, parent, child string) error {
+			if node.DirNode == nil {
+				// node created as part of this visit call, fill in the basics
+				node.Name = base(parent)
+				node.DirNode = &DirectoryNodeProto{}
+			}
+			return nil
+		})
+	})
+}
+
+// PutHardLink creates a file at 'path' that shares the file node of the
+// existing file at 'target': the two paths report the same objects and size,
+// and appends or overwrites through either path update both (unlike CopyFile,
+// whose copies diverge on the next write). This supports dataset layouts with
+// many duplicate files without duplicating their object lists. Links are
+// severed per path by DeleteFile and don't survive Subtree or Merge.
+func (h *dbHashTree) PutHardLink(path string, target string) error {
+	path, target = clean(path), clean(target)
+	return h.Batch(func(tx *bolt.Tx) error {
+		node, err := get(tx, target)
+		if err != nil {
+			return err
+		}
+		if node.nodetype() != file {
+			return errorf(PathConflict, "cannot hard link to %q; it is a %s, "+
+				"not a regular file", target, node.nodetype())
+		}
+		if existing, err := get(tx, path); err != nil && Code(err) != PathNotFound {
+			return err
+		} else if existing != nil && existing.nodetype() != none {
+			return errorf(PathConflict, "could not create hard link at %q; a "+
+				"file of type %s is already there", path, existing.nodetype())
+		}
+		// Join (or create) the target's link group
+		group := links(tx).Get(b(target))
+		if group == nil {
+			group = []byte(uuid.NewWithoutDashes())
+			if err := links(tx).Put(b(target), group); err != nil {
+				return err
+			}
+		}
+		if err := links(tx).Put(b(path), group); err != nil {
+			return err
+		}
+		linked := *node
+		linked.Name = base(path)
+		if err := put(tx, path, &linked); err != nil {
+			return err
+		}
+		return visit(tx, path, func(node *NodeProto, parent, child string) error {
+			if node.DirNode == nil {
+				// node created as part of this visit call, fill in the basics
+				node.Name = base(parent)
+				node.DirNode = &DirectoryNodeProto{}
+			}
+			node.SubtreeSize += linked.SubtreeSize
+			return nil
+		})
+	})
+}
+
+// CopyFile duplicates the file, symlink, or directory subtree at 'src' at
+// 'dst'. Nodes are copied byte-for-byte, so the copies share their object
+// lists with the originals and copying a large subtree doesn't re-put every
+// object reference. 'dst' must not already exist and must not be inside
+// 'src'.
+func (h *dbHashTree) CopyFile(src, dst string) error {
+	src, dst = clean(src), clean(dst)
+	if src == "" || dst == src || strings.HasPrefix(dst, src+"/") {
+		return errorf(PathConflict, "could not copy %q into itself at %q", src, dst)
+	}
+	return h.Batch(func(tx *bolt.Tx) error {
+		node, err := get(tx, src)
+		if err != nil {
+			return err
+		}
+		if existing, err := get(tx, dst); err != nil && Code(err) != PathNotFound {
+			return err
+		} else if existing != nil && existing.nodetype() != none {
+			return errorf(PathConflict, "could not copy to %q; a file of type "+
+				"%s is already there", dst, existing.nodetype())
+		}
+		// Copy the root of the subtree under its new name
+		root := *node
+		root.Name = base(dst)
+		if err := put(tx, dst, &root); err != nil {
+			return err
+		}
+		if node.nodetype() == directory {
+			// Collect the descendants first: bolt cursors aren't stable
+			// across writes to the bucket they iterate
+			var kvs []*keyValue
+			prefix := append(b(src), nullByte...)
+			c := fs(tx).Cursor()
+			for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+				newK := append(append([]byte{}, b(dst)...), k[len(b(src)):]...)
+				newV := make([]byte, len(v))
+				copy(newV, v)
+				kvs = append(kvs, &keyValue{newK, newV})
+			}
+			for _, kv := range kvs {
+				if err := fs(tx).Put(kv.k, kv.v); err != nil {
+					return err
+				}
+			}
+		}
+		// Update dst's ancestors (creating them if need be) with the copied
+		// subtree's size
+		return visit(tx, dst, func(node *NodeProto, parent, child string) error {
+			if node.DirNode == nil {
+				// node created as part of this visit call, fill in the basics
+				node.Name = base(parent)
+				node.DirNode = &DirectoryNodeProto{}
+			}
+			node.SubtreeSize += root.SubtreeSize
+			return nil
+		})
+	})
+}
+
+// SetAttributes merges 'attributes' into the metadata of the node at 'path'.
+// Setting a key to "" deletes it. Attributes travel with the node through
+// Serialize and Merge, and a node that has any becomes part of that node's
+// hash, so metadata edits propagate to ancestor hashes like content edits do.
+func (h *dbHashTree) SetAttributes(path string, attributes map[string]string) error {
+	path = clean(path)
+	return h.Batch(func(tx *bolt.Tx) error {
+		node, err := get(tx, path)
+		if err != nil {
+			return err
+		}
+		for key, value := range attributes {
+			if value == "" {
+				delete(node.Attributes, key)
+				continue
+			}
+			if node.Attributes == nil {
+				node.Attributes = make(map[string]string)
+			}
+			node.Attributes[key] = value
+		}
+		if len(node.Attributes) == 0 {
+			node.Attributes = nil
+		}
+		if err := put(tx, path, node); err != nil {
+			return err
+		}
+		// mark the node's ancestors changed so Hash() picks up the edit
+		return visit(tx, path, nop)
+	})
+}
+
 // deleteDir deletes a directory and all the children under it
 func deleteDir(tx *bolt.Tx, path string) error {
 	c := fs(tx).Cursor()
@@ -884,6 +2357,7 @@ func deleteDir(tx *bolt.Tx, path string) error {
 
 // DeleteFile deletes a regular file or directory (along with its children).
 func (h *dbHashTree) DeleteFile(path string) error {
+	trace("deleteFile", path)
 	path = clean(path)
 
 	// Delete root means delete all files
@@ -892,52 +2366,156 @@ func (h *dbHashTree) DeleteFile(path string) error {
 	}
 	return h.Batch(func(tx *bolt.Tx) error {
 		if err := glob(tx, path, func(path string, node *NodeProto) error {
-			// Check if the file has been deleted already
-			if _, err := get(tx, path); err != nil && Code(err) == PathNotFound {
-				return nil
-			}
-			// Remove 'path' and all nodes underneath it from h.fs
-			if err := deleteDir(tx, path); err != nil {
-				return err
-			}
-			size := node.SubtreeSize
-			// Remove 'path' from its parent directory
-			// TODO(bryce) Decide if this should be removed.
-			parent, _ := split(path)
-			pnode, err := get(tx, parent)
-			if err != nil {
-				if Code(err) == PathNotFound {
-					return errorf(Internal, "delete discovered orphaned file \"%s\"", path)
+			return deleteNode(tx, path, node)
+		}); err != nil && Code(err) != PathNotFound {
+			// Deleting a non-existent file should be a no-op
+			return err
+		}
+		return nil
+	})
+}
+
+// deleteNode removes the node at 'path' and everything under it, removes it
+// from its parent directory, and propagates the size change to its ancestors.
+func deleteNode(tx *bolt.Tx, path string, node *NodeProto) error {
+	// Check if the file has been deleted already
+	if _, err := get(tx, path); err != nil && Code(err) == PathNotFound {
+		return nil
+	}
+	// Remove 'path' and all nodes underneath it from h.fs
+	if err := deleteDir(tx, path); err != nil {
+		return err
+	}
+	// Deleting a path severs its hard links, and those of anything under it
+	// (the other members of each link group keep their contents)
+	if err := links(tx).Delete(b(path)); err != nil {
+		return err
+	}
+	linkPrefix := append(b(path), nullByte...)
+	c := links(tx).Cursor()
+	for k, _ := c.Seek(linkPrefix); k != nil && bytes.HasPrefix(k, linkPrefix); k, _ = c.Next() {
+		if err := c.Delete(); err != nil {
+			return err
+		}
+	}
+	size := node.SubtreeSize
+	// Remove 'path' from its parent directory
+	// TODO(bryce) Decide if this should be removed.
+	parent, _ := split(path)
+	pnode, err := get(tx, parent)
+	if err != nil {
+		if Code(err) == PathNotFound {
+			return errorf(Internal, "delete discovered orphaned file \"%s\"", path)
+		}
+		return err
+	}
+	if pnode.DirNode == nil {
+		return errorf(Internal, "file at \"%s\" is a regular-file, but \"%s\" already exists "+
+			"under it (likely an uncaught PathConflict in prior PutFile or Merge)", path, pnode.DirNode)
+	}
+	put(tx, parent, pnode)
+	// Mark nodes as 'changed' back to root
+	return visit(tx, path, func(node *NodeProto, parent, child string) error {
+		// If node.DirNode is nil it means either the parent didn't
+		// exist (and thus was deserialized fron nil) or it does exist
+		// but thinks it's a file, both are errors.
+		if node.DirNode == nil {
+			return errorf(Internal,
+				"encountered orphaned file \"%s\" while deleting \"%s\"", path,
+				join(parent, child))
+		}
+		node.SubtreeSize -= size
+		return nil
+	})
+}
+
+// DeleteOption configures DeleteFiles.
+type DeleteOption func(*deleteOptions)
+
+type deleteOptions struct {
+	allowGlob bool
+	recursive bool
+	dryRun    bool
+}
+
+// DeleteAllowGlob permits globbing characters in the path, so a single call
+// can delete every match of a pattern.
+func DeleteAllowGlob() DeleteOption {
+	return func(o *deleteOptions) { o.allowGlob = true }
+}
+
+// DeleteRecursive permits deleting non-empty directories (including the
+// root, which deletes every file in the tree).
+func DeleteRecursive() DeleteOption {
+	return func(o *deleteOptions) { o.recursive = true }
+}
+
+// DeleteDryRun reports what would be deleted without deleting anything.
+func DeleteDryRun() DeleteOption {
+	return func(o *deleteOptions) { o.dryRun = true }
+}
+
+// DeleteFiles is DeleteFile with safeguards against accidental wipes, and it
+// returns the paths it deleted (or, with DeleteDryRun, would have deleted).
+// Unlike DeleteFile — which quietly treats the root as "/*" and expands any
+// glob — globbing characters are rejected unless DeleteAllowGlob is passed,
+// and non-empty directories (the root included) are rejected unless
+// DeleteRecursive is passed.
+func (h *dbHashTree) DeleteFiles(path string, opts ...DeleteOption) ([]string, error) {
+	trace("deleteFile", path)
+	path = clean(path)
+	o := &deleteOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if IsGlob(path) && !o.allowGlob {
+		return nil, errorf(MalformedPath, "path (%v) invalid: globbing character (%v) not "+
+			"allowed in path without DeleteAllowGlob", path, globRegex.FindString(path))
+	}
+	if path == "" {
+		// Delete root means delete all files
+		if !o.recursive {
+			return nil, errorf(PathConflict, "deleting the root deletes every file in the "+
+				"tree; pass DeleteRecursive to allow it")
+		}
+		path = "/*"
+	}
+	var matched []string
+	if err := h.Batch(func(tx *bolt.Tx) error {
+		matched = matched[:0] // Batch may retry the transaction
+		var nodes []*NodeProto
+		// Collect and validate all the matches before deleting anything, so a
+		// rejected match doesn't leave a partial delete behind
+		if err := glob(tx, path, func(path string, node *NodeProto) error {
+			if node.DirNode != nil && !o.recursive {
+				if dc := NewChildCursor(tx, path); dc.K() != nil {
+					return errorf(PathConflict, "directory \"%s\" is not empty; pass "+
+						"DeleteRecursive to delete it and everything under it", path)
 				}
-				return err
 			}
-			if pnode.DirNode == nil {
-				return errorf(Internal, "file at \"%s\" is a regular-file, but \"%s\" already exists "+
-					"under it (likely an uncaught PathConflict in prior PutFile or Merge)", path, pnode.DirNode)
-			}
-			put(tx, parent, pnode)
-			// Mark nodes as 'changed' back to root
-			if err := visit(tx, path, func(node *NodeProto, parent, child string) error {
-				// If node.DirNode is nil it means either the parent didn't
-				// exist (and thus was deserialized fron nil) or it does exist
-				// but thinks it's a file, both are errors.
-				if node.DirNode == nil {
-					return errorf(Internal,
-						"encountered orphaned file \"%s\" while deleting \"%s\"", path,
-						join(parent, child))
-				}
-				node.SubtreeSize -= size
+			matched = append(matched, externalDefault(path))
+			nodes = append(nodes, node)
+			return nil
+		}); err != nil {
+			if Code(err) == PathNotFound {
+				// Deleting a non-existent file should be a no-op
 				return nil
-			}); err != nil {
-				return err
 			}
-			return nil
-		}); err != nil && Code(err) != PathNotFound {
-			// Deleting a non-existent file should be a no-op
 			return err
 		}
+		if o.dryRun {
+			return nil
+		}
+		for i, path := range matched {
+			if err := deleteNode(tx, clean(path), nodes[i]); err != nil {
+				return err
+			}
+		}
 		return nil
-	})
+	}); err != nil {
+		return nil, err
+	}
+	return matched, nil
 }
 
 // MergeNode is a node that is typically used for merging.
@@ -1166,6 +2744,11 @@ func pathToTree(k []byte, numTrees int64) uint64 {
 type nodeStream struct {
 	node *MergeNode
 	r    *Reader
+	// idx is the stream's position in the merge's argument order; it breaks
+	// ties between streams at the same path, so nodes always pop in argument
+	// order and merge results don't depend on how the queue happened to
+	// rebalance (see less)
+	idx int
 }
 
 type mergePQ struct {
@@ -1177,6 +2760,15 @@ func (mq *mergePQ) k(i int) []byte {
 	return mq.q[i].node.k
 }
 
+// less orders streams by their current node's path, breaking ties by stream
+// index so merges are deterministic regardless of grouping.
+func (mq *mergePQ) less(i, j int) bool {
+	if c := bytes.Compare(mq.k(i), mq.k(j)); c != 0 {
+		return c < 0
+	}
+	return mq.q[i].idx < mq.q[j].idx
+}
+
 func (mq *mergePQ) insert(s *nodeStream) error {
 	// Get next node in stream
 	var err error
@@ -1192,58 +2784,182 @@ func (mq *mergePQ) insert(s *nodeStream) error {
 	// Propagate insert up the queue
 	i := mq.size
 	for i > 1 {
-		if bytes.Compare(mq.k(i/2), mq.k(i)) <= 0 {
+		if mq.less(i/2, i) {
 			break
 		}
 		mq.swap(i/2, i)
 		i /= 2
 	}
-	return nil
+	return nil
+}
+
+func (mq *mergePQ) next() ([]*MergeNode, error) {
+	ns := []*MergeNode{mq.q[1].node}
+	if err := mq.fill(); err != nil {
+		return nil, err
+	}
+	// Keep popping nodes off the queue if they share the same path
+	for mq.q[1] != nil && bytes.Compare(mq.k(1), ns[0].k) == 0 {
+		ns = append(ns, mq.q[1].node)
+		if err := mq.fill(); err != nil {
+			return nil, err
+		}
+	}
+	return ns, nil
+}
+
+// ConflictStrategy decides how Merge handles a path that has a different
+// type (file vs directory vs symlink) in different source trees.
+type ConflictStrategy int
+
+const (
+	// ConflictError fails the merge with a PathConflict error (the default,
+	// and the historical behavior).
+	ConflictError ConflictStrategy = iota
+	// ConflictPreferFirst keeps the node from the first reader that has the
+	// path (callers typically order their readers newest-first). If that
+	// drops a directory variant, the dropped directory's descendants are
+	// skipped from the merged stream too.
+	ConflictPreferFirst
+	// ConflictUnionDirs keeps and merges the directory variants of the path
+	// (so their children survive) and drops the file and symlink variants;
+	// if no variant is a directory it behaves like ConflictPreferFirst.
+	ConflictUnionDirs
+)
+
+// MergeOption configures Merge and MergeParallel.
+type MergeOption func(*mergeOptions)
+
+type mergeOptions struct {
+	strategy ConflictStrategy
+	resolve  func(path string, ns []*NodeProto) (*NodeProto, error)
+}
+
+// WithConflictStrategy makes Merge resolve type conflicts with 'strategy'
+// instead of failing.
+func WithConflictStrategy(strategy ConflictStrategy) MergeOption {
+	return func(o *mergeOptions) { o.strategy = strategy }
+}
+
+// WithConflictResolver installs a per-path callback for type conflicts: it
+// receives the conflicting nodes in reader order and returns the node to
+// keep. It takes precedence over any ConflictStrategy.
+func WithConflictResolver(f func(path string, ns []*NodeProto) (*NodeProto, error)) MergeOption {
+	return func(o *mergeOptions) { o.resolve = f }
+}
+
+func newMergeOptions(opts []MergeOption) *mergeOptions {
+	o := &mergeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func resolveConflict(ns []*MergeNode, opts *mergeOptions) (*MergeNode, error) {
+	if opts.resolve != nil {
+		protos := make([]*NodeProto, len(ns))
+		for i, n := range ns {
+			protos[i] = n.nodeProto
+		}
+		nodeProto, err := opts.resolve(s(ns[0].k), protos)
+		if err != nil {
+			return nil, err
+		}
+		return &MergeNode{k: ns[0].k, nodeProto: nodeProto}, nil
+	}
+	switch opts.strategy {
+	case ConflictPreferFirst:
+		return ns[0], nil
+	case ConflictUnionDirs:
+		var dirs []*MergeNode
+		for _, n := range ns {
+			if n.nodeProto.nodetype() == directory {
+				dirs = append(dirs, n)
+			}
+		}
+		switch len(dirs) {
+		case 0:
+			return ns[0], nil
+		case 1:
+			return dirs[0], nil
+		default:
+			// all remaining nodes are directories, so this can't recurse again
+			return merge(dirs, opts)
+		}
+	default:
+		return nil, errorf(PathConflict, "could not merge path \"%s\" "+
+			"which is a different type in different hashtrees", s(ns[0].k))
+	}
 }
 
-func (mq *mergePQ) next() ([]*MergeNode, error) {
-	ns := []*MergeNode{mq.q[1].node}
-	if err := mq.fill(); err != nil {
-		return nil, err
+// dirDropped reports whether resolving 'ns' dropped a directory variant (the
+// merged node isn't a directory, but one of the sources was). When it did,
+// the dropped directory's descendants are still in the sorted stream and must
+// be skipped.
+func dirDropped(ns []*MergeNode, merged *MergeNode) bool {
+	if len(ns) == 1 || merged.nodeProto == nil || merged.nodeProto.DirNode != nil {
+		return false
 	}
-	// Keep popping nodes off the queue if they share the same path
-	for mq.q[1] != nil && bytes.Compare(mq.k(1), ns[0].k) == 0 {
-		ns = append(ns, mq.q[1].node)
-		if err := mq.fill(); err != nil {
-			return nil, err
+	for _, n := range ns {
+		if n.nodeProto != nil && n.nodeProto.DirNode != nil {
+			return true
 		}
 	}
-	return ns, nil
+	return false
 }
 
-func merge(ns []*MergeNode) (*MergeNode, error) {
+func merge(ns []*MergeNode, opts *mergeOptions) (*MergeNode, error) {
 	// Skip deserialization if possible
 	if len(ns) == 1 {
 		return ns[0], nil
 	}
-	base := ns[0]
-	base.nodeProto = &NodeProto{}
-	if err := base.nodeProto.Unmarshal(base.v); err != nil {
-		return nil, err
-	}
-	for i := 1; i < len(ns); i++ {
-		n := ns[i]
+	for _, n := range ns {
 		n.nodeProto = &NodeProto{}
 		if err := n.nodeProto.Unmarshal(n.v); err != nil {
 			return nil, err
 		}
+	}
+	base := ns[0]
+	// seen tracks the node hashes already merged in, so identical copies of a
+	// path (overlapping shard trees carry the same object references) collapse
+	// to one instead of duplicating their content and double-counting the size.
+	// Unhashed nodes (trees serialized before Hash() ran) all carry an empty
+	// hash, which says nothing about their content, so they're never deduped.
+	seen := map[string]bool{}
+	if len(base.nodeProto.Hash) > 0 {
+		seen[string(base.nodeProto.Hash)] = true
+	}
+	for i := 1; i < len(ns); i++ {
+		n := ns[i]
 		// Check for inconsistent node types
 		if base.nodeProto.nodetype() != n.nodeProto.nodetype() {
-			return nil, errorf(PathConflict, "could not merge path \"%s\" "+
-				"which is a different type in different hashtrees", s(base.k))
+			return resolveConflict(ns, opts)
+		}
+		if len(n.nodeProto.Hash) > 0 {
+			if seen[string(n.nodeProto.Hash)] {
+				continue
+			}
+			seen[string(n.nodeProto.Hash)] = true
 		}
 		// Merge file content
 		if base.nodeProto.nodetype() == file {
 			base.nodeProto.FileNode.BlockRefs = append(base.nodeProto.FileNode.BlockRefs, n.nodeProto.FileNode.BlockRefs...)
 
 		}
+		// Merge attributes; on conflict the first tree's value wins, matching
+		// how headers/footers and other singleton metadata behave in merges
+		for key, value := range n.nodeProto.Attributes {
+			if _, ok := base.nodeProto.Attributes[key]; !ok {
+				if base.nodeProto.Attributes == nil {
+					base.nodeProto.Attributes = make(map[string]string)
+				}
+				base.nodeProto.Attributes[key] = value
+			}
+		}
 		hasher := pfs.NewHash()
-		hasher.Write(append(base.nodeProto.Hash, n.nodeProto.Hash...))
+		hasher.Write(base.nodeProto.Hash)
+		hasher.Write(n.nodeProto.Hash)
 		base.nodeProto.Hash = hasher.Sum(nil)
 		base.nodeProto.SubtreeSize += n.nodeProto.SubtreeSize
 	}
@@ -1264,12 +2980,12 @@ func (mq *mergePQ) fill() error {
 		l, r := i*2, i*2+1
 		if l > mq.size {
 			break
-		} else if r > mq.size || bytes.Compare(mq.k(l), mq.k(r)) <= 0 {
+		} else if r > mq.size || mq.less(l, r) {
 			next = l
 		} else {
 			next = r
 		}
-		if bytes.Compare(mq.k(i), mq.k(next)) <= 0 {
+		if mq.less(i, next) {
 			break
 		}
 		mq.swap(i, next)
@@ -1283,29 +2999,49 @@ func (mq *mergePQ) swap(i, j int) {
 	mq.q[i], mq.q[j] = mq.q[j], mq.q[i]
 }
 
-// Merge merges a collection of hashtree readers into a hashtree writer.
-func Merge(w *Writer, rs []*Reader) error {
+// Merge merges a collection of hashtree readers into a hashtree writer. The
+// readers are consumed as sorted streams of nodes and merged through a k-way
+// priority queue that holds one node per reader, so the memory Merge uses is
+// proportional to the number of source trees, not their size.
+//
+// By default a path with a different type in different trees is a
+// PathConflict error; 'opts' can resolve such conflicts instead (see
+// WithConflictStrategy and WithConflictResolver).
+func Merge(w *Writer, rs []*Reader, opts ...MergeOption) error {
 	if len(rs) == 0 {
 		return nil
 	}
+	o := newMergeOptions(opts)
 	mq := &mergePQ{q: make([]*nodeStream, len(rs)+1)}
 	// Setup first set of nodes
-	for _, r := range rs {
-		if err := mq.insert(&nodeStream{r: r}); err != nil {
+	for i, r := range rs {
+		if err := mq.insert(&nodeStream{r: r, idx: i}); err != nil {
 			return err
 		}
 	}
+	// skip, when set, is the key prefix of a directory that conflict
+	// resolution dropped; its descendants must be dropped too
+	var skip []byte
 	for mq.q[1] != nil {
 		// Get next nodes to merge
 		ns, err := mq.next()
 		if err != nil {
 			return err
 		}
+		if skip != nil {
+			if bytes.HasPrefix(ns[0].k, skip) {
+				continue
+			}
+			skip = nil
+		}
 		// Merge nodes
-		n, err := merge(ns)
+		n, err := merge(ns, o)
 		if err != nil {
 			return err
 		}
+		if dirDropped(ns, n) {
+			skip = append(append([]byte{}, n.k...), nullByte...)
+		}
 		// Write out result
 		if err := w.Write(n); err != nil {
 			return err
@@ -1314,11 +3050,285 @@ func Merge(w *Writer, rs []*Reader) error {
 	return nil
 }
 
+// mergeCursor walks one reader of a three-way merge, holding the node at the
+// cursor's current position (nil once the stream is exhausted).
+type mergeCursor struct {
+	r *Reader
+	n *MergeNode
+}
+
+func newMergeCursor(r *Reader) (*mergeCursor, error) {
+	c := &mergeCursor{r: r}
+	return c, c.advance()
+}
+
+func (c *mergeCursor) advance() error {
+	n, err := c.r.Read()
+	if err != nil {
+		if err == io.EOF {
+			c.n = nil
+			return nil
+		}
+		return err
+	}
+	n.nodeProto = &NodeProto{}
+	if err := n.nodeProto.Unmarshal(n.v); err != nil {
+		return err
+	}
+	c.n = n
+	return nil
+}
+
+// sameNode reports whether two merge nodes have the same type and hash, i.e.
+// whether the path is unchanged between the trees they came from.
+func sameNode(a, b *MergeNode) bool {
+	return a.nodeProto.nodetype() == b.nodeProto.nodetype() &&
+		bytes.Equal(a.nodeProto.Hash, b.nodeProto.Hash)
+}
+
+// Merge3 three-way merges 'ours' and 'theirs' against their common ancestor
+// 'base', writing the result to 'w'. Unlike Merge, which unions its inputs,
+// Merge3 distinguishes additions from deletions: a path missing from one side
+// but unchanged on the other was deleted there and stays deleted. A path
+// changed on both sides, or changed on one side and deleted on the other, is
+// a conflict, which fails with PathConflict by default; 'opts' can resolve
+// conflicts instead, with 'ours' playing the role of the first reader (so
+// ConflictPreferFirst means ours wins, including its deletions). A conflict
+// resolver may return a nil NodeProto to delete the conflicting path.
+// Directories present on both sides keep their metadata from 'ours' and have
+// their children merged individually.
+func Merge3(w *Writer, base, ours, theirs *Reader, opts ...MergeOption) error {
+	o := newMergeOptions(opts)
+	cs := make([]*mergeCursor, 3)
+	for i, r := range []*Reader{base, ours, theirs} {
+		var err error
+		if cs[i], err = newMergeCursor(r); err != nil {
+			return err
+		}
+	}
+	// skip, when set, is the key prefix of a directory the merge dropped; its
+	// descendants in all three streams must be dropped too
+	var skip []byte
+	for {
+		// Find the smallest key any cursor is at
+		var k []byte
+		for _, c := range cs {
+			if c.n != nil && (k == nil || bytes.Compare(c.n.k, k) < 0) {
+				k = c.n.k
+			}
+		}
+		if k == nil {
+			return nil
+		}
+		// Pop the nodes at that key
+		popped := make([]*MergeNode, 3)
+		for i, c := range cs {
+			if c.n != nil && bytes.Equal(c.n.k, k) {
+				popped[i] = c.n
+				if err := c.advance(); err != nil {
+					return err
+				}
+			}
+		}
+		if skip != nil {
+			if bytes.HasPrefix(k, skip) {
+				continue
+			}
+			skip = nil
+		}
+		n, err := merge3(k, popped[0], popped[1], popped[2], o)
+		if err != nil {
+			return err
+		}
+		// If the result isn't a directory but some variant was, the dropped
+		// directory's descendants must be skipped
+		if n == nil || n.nodeProto.DirNode == nil {
+			for _, v := range popped {
+				if v != nil && v.nodeProto.DirNode != nil {
+					skip = append(append([]byte{}, k...), nullByte...)
+					break
+				}
+			}
+		}
+		if n == nil {
+			continue
+		}
+		if err := w.Write(n); err != nil {
+			return err
+		}
+	}
+}
+
+// merge3 decides a single path of a three-way merge. Any of the three nodes
+// may be nil (the path is absent from that tree); a nil result means the path
+// is deleted from the merged tree.
+func merge3(k []byte, base, ours, theirs *MergeNode, opts *mergeOptions) (*MergeNode, error) {
+	resolve := func(ns ...*MergeNode) (*MergeNode, error) {
+		protos := make([]*NodeProto, len(ns))
+		for i, n := range ns {
+			protos[i] = n.nodeProto
+		}
+		nodeProto, err := opts.resolve(s(k), protos)
+		if err != nil {
+			return nil, err
+		}
+		if nodeProto == nil {
+			return nil, nil
+		}
+		return &MergeNode{k: k, nodeProto: nodeProto}, nil
+	}
+	switch {
+	case ours != nil && theirs != nil:
+		if ours.nodeProto.nodetype() == directory && theirs.nodeProto.nodetype() == directory {
+			return ours, nil
+		}
+		if sameNode(ours, theirs) {
+			return ours, nil
+		}
+		if base != nil && sameNode(ours, base) {
+			return theirs, nil
+		}
+		if base != nil && sameNode(theirs, base) {
+			return ours, nil
+		}
+		// Changed on both sides (or added on both sides with different
+		// contents)
+		if opts.resolve != nil {
+			return resolve(ours, theirs)
+		}
+		switch opts.strategy {
+		case ConflictPreferFirst, ConflictUnionDirs:
+			return ours, nil
+		}
+		return nil, errorf(PathConflict, "path \"%s\" changed in both "+
+			"trees since their common ancestor", s(k))
+	case ours != nil:
+		if base == nil {
+			return ours, nil // added in ours
+		}
+		if sameNode(ours, base) {
+			return nil, nil // deleted in theirs, untouched in ours
+		}
+		// Modified in ours, deleted in theirs
+		if opts.resolve != nil {
+			return resolve(ours)
+		}
+		switch opts.strategy {
+		case ConflictPreferFirst, ConflictUnionDirs:
+			return ours, nil
+		}
+		return nil, errorf(PathConflict, "path \"%s\" was modified in one "+
+			"tree and deleted in the other", s(k))
+	case theirs != nil:
+		if base == nil {
+			return theirs, nil // added in theirs
+		}
+		if sameNode(theirs, base) {
+			return nil, nil // deleted in ours, untouched in theirs
+		}
+		// Modified in theirs, deleted in ours
+		if opts.resolve != nil {
+			return resolve(theirs)
+		}
+		switch opts.strategy {
+		case ConflictPreferFirst, ConflictUnionDirs:
+			return nil, nil // ours wins, and ours deleted it
+		}
+		return nil, errorf(PathConflict, "path \"%s\" was modified in one "+
+			"tree and deleted in the other", s(k))
+	default:
+		return nil, nil // only in the ancestor; deleted on both sides
+	}
+}
+
+// MergeParallel is like Merge, but farms the CPU-heavy part of the merge
+// (unmarshalling and rehashing nodes that appear in several source trees) out
+// to 'concurrency' goroutines. Popping the next set of nodes off the priority
+// queue is inherently sequential, as is writing the results (which stay in
+// sorted-path order), so those remain on the calling goroutine.
+func MergeParallel(w *Writer, rs []*Reader, concurrency int, opts ...MergeOption) error {
+	if len(rs) == 0 {
+		return nil
+	}
+	if concurrency <= 1 {
+		return Merge(w, rs, opts...)
+	}
+	o := newMergeOptions(opts)
+	mq := &mergePQ{q: make([]*nodeStream, len(rs)+1)}
+	// Setup first set of nodes
+	for i, r := range rs {
+		if err := mq.insert(&nodeStream{r: r, idx: i}); err != nil {
+			return err
+		}
+	}
+	type result struct {
+		ns  []*MergeNode
+		n   *MergeNode
+		err error
+	}
+	results := make(chan chan result, 2*concurrency)
+	sem := make(chan struct{}, concurrency)
+	eg, ctx := errgroup.WithContext(context.Background())
+	eg.Go(func() error {
+		defer close(results)
+		for mq.q[1] != nil {
+			// Get next nodes to merge
+			ns, err := mq.next()
+			if err != nil {
+				return err
+			}
+			ch := make(chan result, 1)
+			select {
+			case results <- ch:
+			case <-ctx.Done():
+				return nil // the writer goroutine hit an error
+			}
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return nil
+			}
+			go func() {
+				defer func() { <-sem }()
+				n, err := merge(ns, o)
+				ch <- result{ns, n, err}
+			}()
+		}
+		return nil
+	})
+	eg.Go(func() error {
+		// Write out results in the order their inputs were popped. Dropping a
+		// conflicting directory also drops its descendants, which can only be
+		// detected here where results arrive in sorted-path order.
+		var skip []byte
+		for ch := range results {
+			res := <-ch
+			if res.err != nil {
+				return res.err
+			}
+			if skip != nil {
+				if bytes.HasPrefix(res.n.k, skip) {
+					continue
+				}
+				skip = nil
+			}
+			if dirDropped(res.ns, res.n) {
+				skip = append(append([]byte{}, res.n.k...), nullByte...)
+			}
+			if err := w.Write(res.n); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return eg.Wait()
+}
+
 func nodes(rs []io.ReadCloser, f func(path string, nodeProto *NodeProto) error) error {
 	mq := &mergePQ{q: make([]*nodeStream, len(rs)+1)}
 	// Setup first set of nodes
-	for _, r := range rs {
-		if err := mq.insert(&nodeStream{r: NewReader(r, nil)}); err != nil {
+	for i, r := range rs {
+		if err := mq.insert(&nodeStream{r: NewReader(r, nil), idx: i}); err != nil {
 			return err
 		}
 	}
@@ -1359,7 +3369,7 @@ func HashFileNode(n *FileNodeProto) []byte {
 	return hash.Sum(nil)
 }
 
-func canonicalize(tx *bolt.Tx, path string) error {
+func canonicalize(tx *bolt.Tx, path string, hasher *nodeHasher) error {
 	path = clean(path)
 	if !hasChanged(tx, path) {
 		return nil // Node is already canonical
@@ -1375,12 +3385,12 @@ func canonicalize(tx *bolt.Tx, path string) error {
 	// Compute hash of 'n'
 	switch n.nodetype() {
 	case directory:
-		hash := sha256.New()
-		// Compute n.Hash by concatenating name + hash of all children of n.DirNode
+		dh := hasher.newDirHasher()
+		// Compute n.Hash by folding in name + hash of all children of n.DirNode
 		// Note that the order of the children of n.DirNode are sorted when iterating.
 		if err := iterDir(tx, path, func(k, _ []byte, _ *bolt.Cursor) error {
 			childPath := s(k)
-			if err := canonicalize(tx, childPath); err != nil {
+			if err := canonicalize(tx, childPath, hasher); err != nil {
 				return err
 			}
 			childNode, err := get(tx, childPath)
@@ -1391,20 +3401,28 @@ func canonicalize(tx *bolt.Tx, path string) error {
 				}
 				return err
 			}
-			// append child.Name and child.Hash to b
-			hash.Write([]byte(fmt.Sprintf("%s:%s:", childNode.Name, childNode.Hash)))
+			dh.writeChild(childNode.Name, childNode.Hash)
 			return nil
 		}); err != nil {
 			return err
 		}
 		// Update hash of 'n'
-		n.Hash = hash.Sum(nil)
+		n.Hash = dh.sum()
 	case file:
-		n.Hash = HashFileNode(n.FileNode)
+		n.Hash = hasher.hashFile(n.FileNode)
+	case symlink:
+		// a symlink's hash covers its target, so re-pointing a link changes
+		// the hashes of the directories above it like any other edit
+		n.Hash = hasher.hashSymlink(n.SymlinkNode)
 	default:
 		return errorf(Internal,
 			"malformed file at \"%s\" is neither a file nor a directory", path)
 	}
+	// Attributes are opt-in: nodes without any hash exactly as before, but
+	// once a node carries attributes they're part of its identity
+	if len(n.Attributes) > 0 {
+		n.Hash = hasher.hashWithAttributes(n.Hash, n.Attributes)
+	}
 
 	if err := put(tx, path, n); err != nil {
 		return err
@@ -1415,9 +3433,257 @@ func canonicalize(tx *bolt.Tx, path string) error {
 // Hash updates all of the hashes and node size metadata, it also checks
 // for conflicts.
 func (h *dbHashTree) Hash() error {
+	if h.hasher.frozen {
+		return errorf(Unsupported,
+			"tree was written with format %d, but this version of pachyderm only "+
+				"supports formats up to %d; it can be read but not modified", h.hasher.format, MaxSupportedFormat())
+	}
 	return h.Batch(func(tx *bolt.Tx) error {
-		return canonicalize(tx, "")
+		return canonicalize(tx, "", h.hasher)
+	})
+}
+
+// TreeStats summarizes a hashtree: node and object-reference counts, the
+// deepest path, and a histogram of file sizes.
+type TreeStats struct {
+	FileCount    int64
+	DirCount     int64
+	SymlinkCount int64
+	// MaxDepth is the depth of the deepest node (the root is depth 0)
+	MaxDepth int64
+	// ObjectCount is the total number of object and block references held by
+	// file nodes
+	ObjectCount int64
+	// SizeHistogram counts files by power-of-two size bucket: index i counts
+	// files with size in [2^(i-1), 2^i), with index 0 counting empty files
+	SizeHistogram []int64
+}
+
+// Stats computes the tree's statistics in a single walk over the nodes, for
+// inspection tooling and capacity planning.
+func (h *dbHashTree) Stats() (*TreeStats, error) {
+	stats := &TreeStats{}
+	if err := h.View(func(tx *bolt.Tx) error {
+		c := fs(tx).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			node := &NodeProto{}
+			if err := node.Unmarshal(v); err != nil {
+				return err
+			}
+			path := s(k)
+			if depth := int64(strings.Count(path, "/")); depth > stats.MaxDepth {
+				stats.MaxDepth = depth
+			}
+			switch node.nodetype() {
+			case directory:
+				stats.DirCount++
+			case file:
+				stats.FileCount++
+				stats.ObjectCount += int64(len(node.FileNode.Objects) + len(node.FileNode.BlockRefs))
+				bucket := bits.Len64(uint64(node.SubtreeSize))
+				for len(stats.SizeHistogram) <= bucket {
+					stats.SizeHistogram = append(stats.SizeHistogram, 0)
+				}
+				stats.SizeHistogram[bucket]++
+			case symlink:
+				stats.SymlinkCount++
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// ValidationProblem describes one structural invariant that Validate found
+// violated, and at which node.
+type ValidationProblem struct {
+	Path    string
+	Message string
+}
+
+func (p *ValidationProblem) String() string {
+	path := p.Path
+	if path == "" {
+		path = "/"
+	}
+	return fmt.Sprintf("%s: %s", path, p.Message)
+}
+
+// Validate checks the tree's structural invariants: every node unmarshals and
+// has a recognized type, every non-root node's parent exists and is a
+// directory, directory sizes equal the sum of their children's, and stored
+// hashes match recomputed ones. It returns one problem per violation (nil if
+// the tree is well-formed). Validate is read-only; nodes with pending changes
+// (i.e. written since the last Hash()) are exempt from the size and hash
+// checks, as those only become canonical when Hash() runs.
+func (h *dbHashTree) Validate() ([]*ValidationProblem, error) {
+	var problems []*ValidationProblem
+	report := func(path string, format string, args ...interface{}) {
+		problems = append(problems, &ValidationProblem{
+			Path:    path,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+	if err := h.View(func(tx *bolt.Tx) error {
+		c := fs(tx).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			path := s(k)
+			node := &NodeProto{}
+			if err := node.Unmarshal(v); err != nil {
+				report(path, "malformed node: %v", err)
+				continue
+			}
+			switch node.nodetype() {
+			case directory, file, symlink:
+			default:
+				report(path, "node is neither a file, directory, nor symlink")
+				continue
+			}
+			// Every non-root node must hang off a directory
+			if path != "" {
+				parent, _ := split(path)
+				pnode, err := get(tx, parent)
+				if err != nil {
+					if Code(err) == PathNotFound {
+						report(path, "orphaned: parent %q does not exist", parent)
+						continue
+					}
+					return err
+				}
+				if pnode.nodetype() != directory {
+					report(path, "parent %q is a %s, not a directory", parent, pnode.nodetype())
+					continue
+				}
+			}
+			if hasChanged(tx, path) {
+				continue // size and hash aren't canonical until Hash() runs
+			}
+			// Recompute the node's size (directories only; a file's size can't
+			// be re-derived from its object references) and hash, and compare
+			// them against what's stored
+			var expectedHash []byte
+			switch node.nodetype() {
+			case directory:
+				var size int64
+				dh := h.hasher.newDirHasher()
+				childrenCanonical := true
+				if err := iterDir(tx, path, func(k, v []byte, _ *bolt.Cursor) error {
+					if hasChanged(tx, s(k)) {
+						childrenCanonical = false
+						return errutil.ErrBreak
+					}
+					child := &NodeProto{}
+					if err := child.Unmarshal(v); err != nil {
+						// reported when the cursor reaches the child itself
+						childrenCanonical = false
+						return errutil.ErrBreak
+					}
+					size += child.SubtreeSize
+					dh.writeChild(child.Name, child.Hash)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if !childrenCanonical {
+					continue
+				}
+				if size != node.SubtreeSize {
+					report(path, "size is %d, but children sum to %d", node.SubtreeSize, size)
+				}
+				expectedHash = dh.sum()
+			case file:
+				expectedHash = h.hasher.hashFile(node.FileNode)
+			case symlink:
+				expectedHash = h.hasher.hashSymlink(node.SymlinkNode)
+			}
+			if len(node.Attributes) > 0 {
+				expectedHash = h.hasher.hashWithAttributes(expectedHash, node.Attributes)
+			}
+			if !bytes.Equal(node.Hash, expectedHash) {
+				report(path, "hash is %x, but recomputing yields %x", node.Hash, expectedHash)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return problems, nil
+}
+
+// SizeDiscrepancy records one directory whose stored SubtreeSize didn't match
+// the sum of its children, and what RecomputeSizes corrected it to.
+type SizeDiscrepancy struct {
+	Path     string
+	Stored   int64
+	Computed int64
+}
+
+func (d *SizeDiscrepancy) String() string {
+	return fmt.Sprintf("%s: stored size %d, computed size %d", externalDefault(d.Path), d.Stored, d.Computed)
+}
+
+// RecomputeSizes re-derives every directory's SubtreeSize bottom-up from its
+// children (leaf sizes are taken as the source of truth, and shared
+// header/footer sizes are counted like PutDirHeaderFooter counts them),
+// repairs any directory that drifted, and reports the discrepancies. Size
+// drift from historical bugs otherwise goes undetected and skews quota and
+// metrics.
+func (h *dbHashTree) RecomputeSizes() ([]*SizeDiscrepancy, error) {
+	var discrepancies []*SizeDiscrepancy
+	if err := h.Update(func(tx *bolt.Tx) error {
+		// Walk the keys in reverse sorted order, so every node is visited
+		// after all of its descendants, accumulating each node's final size
+		// into its parent's sum
+		childSums := make(map[string]int64)
+		c := fs(tx).Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			path := s(k)
+			node := &NodeProto{}
+			if err := node.Unmarshal(v); err != nil {
+				return err
+			}
+			size := node.SubtreeSize
+			if node.DirNode != nil {
+				computed := childSums[path]
+				if node.DirNode.Shared != nil {
+					computed += node.DirNode.Shared.HeaderSize + node.DirNode.Shared.FooterSize
+				}
+				if computed != node.SubtreeSize {
+					discrepancies = append(discrepancies, &SizeDiscrepancy{
+						Path:     path,
+						Stored:   node.SubtreeSize,
+						Computed: computed,
+					})
+				}
+				size = computed
+			}
+			if path != "" {
+				parent, _ := split(path)
+				childSums[parent] += size
+			}
+		}
+		// Apply the repairs after the cursor walk (mutating the bucket
+		// mid-iteration invalidates the cursor)
+		for _, d := range discrepancies {
+			node, err := get(tx, d.Path)
+			if err != nil {
+				return err
+			}
+			node.SubtreeSize = d.Computed
+			if err := put(tx, d.Path, node); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(discrepancies, func(i, j int) bool {
+		return discrepancies[i].Path < discrepancies[j].Path
 	})
+	return discrepancies, nil
 }
 
 type nodetype uint8
@@ -1426,17 +3692,20 @@ const (
 	none         nodetype = iota // No file is present at this point in the tree
 	directory                    // The file at this point in the tree is a directory
 	file                         // ... is a regular file
+	symlink                      // ... is a symbolic link
 	unrecognized                 // ... is an an unknown type
 )
 
 func (n *NodeProto) nodetype() nodetype {
 	switch {
-	case n == nil || (n.DirNode == nil && n.FileNode == nil):
+	case n == nil || (n.DirNode == nil && n.FileNode == nil && n.SymlinkNode == nil):
 		return none
 	case n.DirNode != nil:
 		return directory
 	case n.FileNode != nil:
 		return file
+	case n.SymlinkNode != nil:
+		return symlink
 	default:
 		return unrecognized
 	}
@@ -1450,6 +3719,8 @@ func (n nodetype) String() string {
 		return "directory"
 	case file:
 		return "file"
+	case symlink:
+		return "symlink"
 	default:
 		return "unknown"
 	}
@@ -1746,7 +4017,10 @@ func (o *Ordered) Serialize(_w io.Writer) error {
 }
 
 // Unordered is an in memory version of the hashtree that supports random inserts. This will look more like the old version of hashtrees over time, with the key differences being that it supports arbitrary rooting and can easily be converted into a sorted tree.
+// Unordered is safe for concurrent use, so workers can build one tree from
+// multiple goroutines without an external mutex.
 type Unordered struct {
+	mu   sync.Mutex
 	fs   map[string]*NodeProto
 	root string
 }
@@ -1761,6 +4035,8 @@ func NewUnordered(root string) *Unordered {
 
 // PutFile puts a file in the hashtree.
 func (u *Unordered) PutFile(path string, hash []byte, size int64, blockRefs ...*pfs.BlockRef) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
 	path = join(u.root, path)
 	nodeProto := &NodeProto{
 		Name:        base(path),
@@ -1792,6 +4068,8 @@ func (u *Unordered) createParents(path string) {
 
 // Ordered converts an unordered hashtree into an ordered hashtree.
 func (u *Unordered) Ordered() *Ordered {
+	u.mu.Lock()
+	defer u.mu.Unlock()
 	paths := make([]string, len(u.fs))
 	i := 0
 	for path := range u.fs {
@@ -1811,3 +4089,82 @@ func (u *Unordered) Ordered() *Ordered {
 	}
 	return o
 }
+
+// SpillingUnordered is an Unordered hashtree with a memory budget: once the
+// in-memory nodes exceed approximately 'budget' bytes, the finished tree is
+// serialized through the spill callback (which should write it to the object
+// store) and the in-memory map is reset. The spilled trees plus the final one
+// are combined by the caller with Merge/MergeParallel, so workers ingesting
+// massive datasets stay under their memory limits. Note that Merge
+// concatenates files that appear in several trees, so a path should only be
+// put once across all spills.
+type SpillingUnordered struct {
+	mu      sync.Mutex
+	u       *Unordered
+	root    string
+	budget  int64
+	used    int64
+	spill   func(r io.Reader) error
+	spilled int
+}
+
+// NewSpillingUnordered creates a new unordered hashtree that spills itself
+// through 'spill' whenever its in-memory nodes exceed 'budget' bytes.
+func NewSpillingUnordered(root string, budget int64, spill func(r io.Reader) error) *SpillingUnordered {
+	return &SpillingUnordered{
+		u:      NewUnordered(root),
+		root:   root,
+		budget: budget,
+		spill:  spill,
+	}
+}
+
+// PutFile puts a file in the hashtree, spilling the tree if this put pushes
+// it over the memory budget.
+func (su *SpillingUnordered) PutFile(path string, hash []byte, size int64, blockRefs ...*pfs.BlockRef) error {
+	su.mu.Lock()
+	defer su.mu.Unlock()
+	su.u.PutFile(path, hash, size, blockRefs...)
+	// Estimate what the node costs in memory by its marshalled size plus its
+	// key in the map
+	n := &NodeProto{
+		Name:        base(path),
+		Hash:        hash,
+		SubtreeSize: size,
+		FileNode:    &FileNodeProto{BlockRefs: blockRefs},
+	}
+	su.used += int64(n.Size() + len(path))
+	if su.used > su.budget {
+		return su.spillTree()
+	}
+	return nil
+}
+
+// spillTree serializes the current in-memory tree through the spill callback
+// and resets it. Callers must hold su.mu.
+func (su *SpillingUnordered) spillTree() error {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(su.u.Ordered().Serialize(pw))
+	}()
+	if err := su.spill(pr); err != nil {
+		return err
+	}
+	su.u = NewUnordered(su.root)
+	su.used = 0
+	su.spilled++
+	return nil
+}
+
+// Finish spills whatever is still in memory and returns how many trees were
+// spilled in total. No puts may follow.
+func (su *SpillingUnordered) Finish() (int, error) {
+	su.mu.Lock()
+	defer su.mu.Unlock()
+	if su.used > 0 || su.spilled == 0 {
+		if err := su.spillTree(); err != nil {
+			return 0, err
+		}
+	}
+	return su.spilled, nil
+}
@@ -0,0 +1,52 @@
+package hashtree
+
+import (
+	"sync/atomic"
+
+	lru "github.com/hashicorp/golang-lru"
+	globlib "github.com/pachyderm/glob"
+)
+
+// globCacheSize bounds the package-level cache of compiled glob patterns.
+// Pipelines evaluate a handful of patterns against many trees, so even a
+// small cache makes compilation an almost guaranteed hit.
+const globCacheSize = 1024
+
+var (
+	globCache       *lru.Cache
+	globCacheHits   int64
+	globCacheMisses int64
+)
+
+func init() {
+	var err error
+	globCache, err = lru.New(globCacheSize)
+	if err != nil {
+		// lru.New only fails on a non-positive size
+		panic(err)
+	}
+}
+
+// compileGlob returns the compiled form of 'pattern', memoizing results in a
+// package-level LRU cache. Malformed patterns return a MalformedGlob error
+// (and aren't cached).
+func compileGlob(pattern string) (*globlib.Glob, error) {
+	if g, ok := globCache.Get(pattern); ok {
+		atomic.AddInt64(&globCacheHits, 1)
+		return g.(*globlib.Glob), nil
+	}
+	atomic.AddInt64(&globCacheMisses, 1)
+	g, err := globlib.Compile(pattern, '/')
+	if err != nil {
+		return nil, errorf(MalformedGlob, err.Error())
+	}
+	globCache.Add(pattern, g)
+	return g, nil
+}
+
+// GlobCacheStats reports how often glob compilation has hit and missed the
+// pattern cache since the process started, for metrics on cache
+// effectiveness.
+func GlobCacheStats() (hits, misses int64) {
+	return atomic.LoadInt64(&globCacheHits), atomic.LoadInt64(&globCacheMisses)
+}
@@ -0,0 +1,189 @@
+package hashtree
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"sort"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// FormatV1 is the original tree format: node hashes are SHA-256 over
+	// unframed concatenation of the hashed fields. Serialized v1 trees
+	// predate the format header, so 0 doubles as "no format recorded".
+	FormatV1 uint32 = 0
+	// FormatV2 length-prefixes every field folded into a node hash (so
+	// adjacent fields can't collide by shifting bytes between them) and
+	// records the hash algorithm in the serialized tree's header.
+	FormatV2 uint32 = 1
+)
+
+const (
+	// HashAlgorithmSHA256 hashes nodes with SHA-256 (the v1 default).
+	HashAlgorithmSHA256 = "sha256"
+	// HashAlgorithmBLAKE2b hashes nodes with BLAKE2b-256, which is
+	// considerably faster than SHA-256 in software. Only valid with
+	// FormatV2.
+	HashAlgorithmBLAKE2b = "blake2b"
+)
+
+// nodeHasher computes node hashes for one tree format and algorithm. The
+// zero-value-equivalent defaultHasher reproduces v1 hashes exactly, so trees
+// created or deserialized without a format keep their historical hashes.
+type nodeHasher struct {
+	format    uint32
+	algorithm string
+
+	// frozen marks a hasher for a format newer than this build understands:
+	// the tree can be read, but not rehashed (see newCompatNodeHasher)
+	frozen bool
+}
+
+var defaultHasher = &nodeHasher{format: FormatV1, algorithm: HashAlgorithmSHA256}
+
+// formatSpec describes one tree format: the hash algorithms it allows. New
+// formats register here, so everything that needs to know what this build
+// supports (version checks, upgrade-required errors) reads one table.
+type formatSpec struct {
+	algorithms map[string]bool
+}
+
+var formatRegistry = map[uint32]*formatSpec{
+	FormatV1: {algorithms: map[string]bool{HashAlgorithmSHA256: true}},
+	FormatV2: {algorithms: map[string]bool{HashAlgorithmSHA256: true, HashAlgorithmBLAKE2b: true}},
+}
+
+// MaxSupportedFormat returns the newest tree format this build can write and
+// rehash.
+func MaxSupportedFormat() uint32 {
+	var max uint32
+	for format := range formatRegistry {
+		if format > max {
+			max = format
+		}
+	}
+	return max
+}
+
+// newNodeHasher validates a format/algorithm pair (e.g. from a serialized
+// tree's header) and returns the hasher for it.
+func newNodeHasher(format uint32, algorithm string) (*nodeHasher, error) {
+	if algorithm == "" {
+		algorithm = HashAlgorithmSHA256
+	}
+	spec, ok := formatRegistry[format]
+	if !ok {
+		return nil, errorf(Unsupported,
+			"tree was written with format %d, but this version of pachyderm only "+
+				"supports formats up to %d; upgrade pachd to read it", format, MaxSupportedFormat())
+	}
+	if !spec.algorithms[algorithm] {
+		return nil, errorf(Unsupported, "unknown hash algorithm %q for tree format %d", algorithm, format)
+	}
+	if format == FormatV1 {
+		return defaultHasher, nil
+	}
+	return &nodeHasher{format: format, algorithm: algorithm}, nil
+}
+
+// newCompatNodeHasher is newNodeHasher, except that an unknown (newer) format
+// yields a frozen hasher instead of an error: the tree's nodes can still be
+// read and its serialized form round-trips unchanged, but rehashing it
+// returns an upgrade-required error (see Hash). This keeps mixed-version
+// clusters working during rolling upgrades, where an older pachd may be
+// handed a tree written by a newer one.
+func newCompatNodeHasher(format uint32, algorithm string) (*nodeHasher, error) {
+	hasher, err := newNodeHasher(format, algorithm)
+	if err == nil || Code(err) != Unsupported {
+		return hasher, err
+	}
+	return &nodeHasher{format: format, algorithm: algorithm, frozen: true}, nil
+}
+
+func (nh *nodeHasher) new() hash.Hash {
+	if nh.algorithm == HashAlgorithmBLAKE2b {
+		h, err := blake2b.New256(nil)
+		if err != nil {
+			// blake2b.New256 can only fail when given a key
+			panic(err)
+		}
+		return h
+	}
+	return sha256.New()
+}
+
+// frame folds 'b' into 'h'. Under FormatV2 the bytes are length-prefixed so
+// that distinct field sequences can't produce the same hash input; under
+// FormatV1 they're written raw, preserving the original hashes.
+func (nh *nodeHasher) frame(h hash.Hash, b []byte) {
+	if nh.format >= FormatV2 {
+		var lenBuf [8]byte
+		binary.BigEndian.PutUint64(lenBuf[:], uint64(len(b)))
+		h.Write(lenBuf[:])
+	}
+	h.Write(b)
+}
+
+func (nh *nodeHasher) hashFile(n *FileNodeProto) []byte {
+	if nh.format == FormatV1 {
+		return HashFileNode(n)
+	}
+	h := nh.new()
+	for _, object := range n.Objects {
+		nh.frame(h, []byte(object.Hash))
+	}
+	return h.Sum(nil)
+}
+
+func (nh *nodeHasher) hashSymlink(n *SymlinkNodeProto) []byte {
+	h := nh.new()
+	nh.frame(h, []byte(n.Target))
+	return h.Sum(nil)
+}
+
+// dirHasher accumulates the children of one directory into its hash.
+type dirHasher struct {
+	nh *nodeHasher
+	h  hash.Hash
+}
+
+func (nh *nodeHasher) newDirHasher() *dirHasher {
+	return &dirHasher{nh: nh, h: nh.new()}
+}
+
+func (d *dirHasher) writeChild(name string, hash []byte) {
+	if d.nh.format == FormatV1 {
+		d.h.Write([]byte(fmt.Sprintf("%s:%s:", name, hash)))
+		return
+	}
+	d.nh.frame(d.h, []byte(name))
+	d.nh.frame(d.h, hash)
+}
+
+func (d *dirHasher) sum() []byte {
+	return d.h.Sum(nil)
+}
+
+// hashWithAttributes folds a node's attributes into its content hash. Keys
+// are visited in sorted order so the result is deterministic.
+func (nh *nodeHasher) hashWithAttributes(contentHash []byte, attributes map[string]string) []byte {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	h := nh.new()
+	nh.frame(h, contentHash)
+	for _, key := range keys {
+		if nh.format == FormatV1 {
+			fmt.Fprintf(h, "%s=%s;", key, attributes[key])
+			continue
+		}
+		nh.frame(h, []byte(key))
+		nh.frame(h, []byte(attributes[key]))
+	}
+	return h.Sum(nil)
+}
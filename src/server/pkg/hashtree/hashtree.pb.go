@@ -21,7 +21,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // FileNodeProto is a node corresponding to a file (which is also a leaf node).
 type FileNodeProto struct {
@@ -175,6 +175,59 @@ func (m *Shared) GetFooterSize() int64 {
 	return 0
 }
 
+// SymlinkNodeProto is a node corresponding to a symbolic link (also a leaf
+// node), so that datasets containing symlinks can be ingested and re-exported
+// faithfully.
+type SymlinkNodeProto struct {
+	// target is the path the link points to, either absolute (within the tree)
+	// or relative to the link's directory. It is stored verbatim; resolution
+	// (including cycle protection) happens at read time.
+	Target               string   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SymlinkNodeProto) Reset()         { *m = SymlinkNodeProto{} }
+func (m *SymlinkNodeProto) String() string { return proto.CompactTextString(m) }
+func (*SymlinkNodeProto) ProtoMessage()    {}
+func (*SymlinkNodeProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4bd44075bd9a7a70, []int{2}
+}
+func (m *SymlinkNodeProto) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymlinkNodeProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymlinkNodeProto.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SymlinkNodeProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymlinkNodeProto.Merge(m, src)
+}
+func (m *SymlinkNodeProto) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymlinkNodeProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymlinkNodeProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymlinkNodeProto proto.InternalMessageInfo
+
+func (m *SymlinkNodeProto) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
 // DirectoryNodeProto is a node corresponding to a directory.
 type DirectoryNodeProto struct {
 	// Children of this directory. Note that paths are relative, so if "/foo/bar"
@@ -182,6 +235,12 @@ type DirectoryNodeProto struct {
 	//
 	// 'Children' is ordered alphabetically, to quickly check if a new file is
 	// overwriting an existing one.
+	//
+	// The database-backed hashtree doesn't maintain this field: children live
+	// as sorted keys in the node keyspace (a B-tree), so inserts into wide
+	// directories are O(log n) and child lists of any size can be streamed
+	// with a cursor instead of being rewritten on every insert. The field
+	// remains for serialized trees from older versions.
 	Children []string `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
 	// shared, if set, references data that will be prepended and appended to all
 	// direct children of this directory (which must all have has_header_footer
@@ -196,7 +255,7 @@ func (m *DirectoryNodeProto) Reset()         { *m = DirectoryNodeProto{} }
 func (m *DirectoryNodeProto) String() string { return proto.CompactTextString(m) }
 func (*DirectoryNodeProto) ProtoMessage()    {}
 func (*DirectoryNodeProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4bd44075bd9a7a70, []int{2}
+	return fileDescriptor_4bd44075bd9a7a70, []int{3}
 }
 func (m *DirectoryNodeProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,18 +311,24 @@ type NodeProto struct {
 	SubtreeSize int64 `protobuf:"varint,3,opt,name=subtree_size,json=subtreeSize,proto3" json:"subtree_size,omitempty"`
 	// Exactly one of the following fields must be set. The type of this node will
 	// be determined by which field is set.
-	FileNode             *FileNodeProto      `protobuf:"bytes,4,opt,name=file_node,json=fileNode,proto3" json:"file_node,omitempty"`
-	DirNode              *DirectoryNodeProto `protobuf:"bytes,5,opt,name=dir_node,json=dirNode,proto3" json:"dir_node,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	FileNode    *FileNodeProto      `protobuf:"bytes,4,opt,name=file_node,json=fileNode,proto3" json:"file_node,omitempty"`
+	DirNode     *DirectoryNodeProto `protobuf:"bytes,5,opt,name=dir_node,json=dirNode,proto3" json:"dir_node,omitempty"`
+	SymlinkNode *SymlinkNodeProto   `protobuf:"bytes,6,opt,name=symlink_node,json=symlinkNode,proto3" json:"symlink_node,omitempty"`
+	// attributes are optional key/value metadata attached to this node
+	// (content-type, tags, provenance hints, etc.), so downstream tools don't
+	// need sidecar files. Attributes survive Merge (values from the first tree
+	// win on conflict) and, when present, are covered by the node's hash.
+	Attributes           map[string]string `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *NodeProto) Reset()         { *m = NodeProto{} }
 func (m *NodeProto) String() string { return proto.CompactTextString(m) }
 func (*NodeProto) ProtoMessage()    {}
 func (*NodeProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4bd44075bd9a7a70, []int{3}
+	return fileDescriptor_4bd44075bd9a7a70, []int{4}
 }
 func (m *NodeProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,6 +392,20 @@ func (m *NodeProto) GetDirNode() *DirectoryNodeProto {
 	return nil
 }
 
+func (m *NodeProto) GetSymlinkNode() *SymlinkNodeProto {
+	if m != nil {
+		return m.SymlinkNode
+	}
+	return nil
+}
+
+func (m *NodeProto) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
 // HashTreeProto is a tree corresponding to the complete file contents of a
 // pachyderm repo at a given commit (based on a Merkle Tree). We store one
 // HashTree for every PFS commit.
@@ -352,7 +431,7 @@ func (m *HashTreeProto) Reset()         { *m = HashTreeProto{} }
 func (m *HashTreeProto) String() string { return proto.CompactTextString(m) }
 func (*HashTreeProto) ProtoMessage()    {}
 func (*HashTreeProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4bd44075bd9a7a70, []int{4}
+	return fileDescriptor_4bd44075bd9a7a70, []int{5}
 }
 func (m *HashTreeProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,7 +475,14 @@ func (m *HashTreeProto) GetFs() map[string]*NodeProto {
 }
 
 type BucketHeader struct {
-	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// format and hash_algorithm describe how this tree's node hashes were
+	// computed (see FormatV* and HashAlgorithm* in hash.go). v1 trees predate
+	// these fields, so their zero values mean the original format: SHA-256 over
+	// unframed concatenation. They're only meaningful on the first header of a
+	// serialized tree.
+	Format               uint32   `protobuf:"varint,2,opt,name=format,proto3" json:"format,omitempty"`
+	HashAlgorithm        string   `protobuf:"bytes,3,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -406,7 +492,7 @@ func (m *BucketHeader) Reset()         { *m = BucketHeader{} }
 func (m *BucketHeader) String() string { return proto.CompactTextString(m) }
 func (*BucketHeader) ProtoMessage()    {}
 func (*BucketHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4bd44075bd9a7a70, []int{5}
+	return fileDescriptor_4bd44075bd9a7a70, []int{6}
 }
 func (m *BucketHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,6 +528,20 @@ func (m *BucketHeader) GetBucket() string {
 	return ""
 }
 
+func (m *BucketHeader) GetFormat() uint32 {
+	if m != nil {
+		return m.Format
+	}
+	return 0
+}
+
+func (m *BucketHeader) GetHashAlgorithm() string {
+	if m != nil {
+		return m.HashAlgorithm
+	}
+	return ""
+}
+
 type Index struct {
 	K                    []byte   `protobuf:"bytes,1,opt,name=k,proto3" json:"k,omitempty"`
 	Offset               uint64   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
@@ -454,7 +554,7 @@ func (m *Index) Reset()         { *m = Index{} }
 func (m *Index) String() string { return proto.CompactTextString(m) }
 func (*Index) ProtoMessage()    {}
 func (*Index) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4bd44075bd9a7a70, []int{6}
+	return fileDescriptor_4bd44075bd9a7a70, []int{7}
 }
 func (m *Index) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,55 +600,66 @@ func (m *Index) GetOffset() uint64 {
 func init() {
 	proto.RegisterType((*FileNodeProto)(nil), "hashtree.FileNodeProto")
 	proto.RegisterType((*Shared)(nil), "hashtree.Shared")
+	proto.RegisterType((*SymlinkNodeProto)(nil), "hashtree.SymlinkNodeProto")
 	proto.RegisterType((*DirectoryNodeProto)(nil), "hashtree.DirectoryNodeProto")
 	proto.RegisterType((*NodeProto)(nil), "hashtree.NodeProto")
+	proto.RegisterMapType((map[string]string)(nil), "hashtree.NodeProto.AttributesEntry")
 	proto.RegisterType((*HashTreeProto)(nil), "hashtree.HashTreeProto")
 	proto.RegisterMapType((map[string]*NodeProto)(nil), "hashtree.HashTreeProto.FsEntry")
 	proto.RegisterType((*BucketHeader)(nil), "hashtree.BucketHeader")
 	proto.RegisterType((*Index)(nil), "hashtree.Index")
 }
 
-func init() { proto.RegisterFile("server/pkg/hashtree/hashtree.proto", fileDescriptor_4bd44075bd9a7a70) }
+func init() {
+	proto.RegisterFile("server/pkg/hashtree/hashtree.proto", fileDescriptor_4bd44075bd9a7a70)
+}
 
 var fileDescriptor_4bd44075bd9a7a70 = []byte{
-	// 582 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x65, 0x6d, 0x27, 0x71, 0x26, 0xa9, 0x08, 0x0b, 0x02, 0xab, 0x42, 0x69, 0x30, 0x02, 0x05,
-	0x04, 0x89, 0x54, 0x10, 0x20, 0x8e, 0x15, 0x54, 0x25, 0x07, 0x40, 0x5b, 0x4e, 0x5c, 0x22, 0x7f,
-	0x8c, 0x6b, 0x13, 0xd7, 0x1b, 0xed, 0x3a, 0x15, 0xe9, 0xef, 0xe0, 0xc0, 0x99, 0x03, 0xff, 0x04,
-	0x89, 0x23, 0x3f, 0x01, 0x95, 0x3f, 0x82, 0xf6, 0xa3, 0x75, 0x0b, 0x3d, 0x44, 0x9a, 0xf7, 0xe6,
-	0xcd, 0xf3, 0xbc, 0xcd, 0x2e, 0x84, 0x12, 0xc5, 0x11, 0x8a, 0xe9, 0x72, 0x71, 0x30, 0xcd, 0x23,
-	0x99, 0xd7, 0x02, 0xf1, 0xac, 0x98, 0x2c, 0x05, 0xaf, 0x39, 0xf5, 0x4f, 0xf1, 0xe6, 0x8d, 0xa4,
-	0x2c, 0xb0, 0xaa, 0xa7, 0xcb, 0x4c, 0xaa, 0x9f, 0xe9, 0x87, 0xdf, 0x08, 0x6c, 0xec, 0x16, 0x25,
-	0xbe, 0xe5, 0x29, 0xbe, 0xd7, 0x13, 0xf7, 0xa0, 0xc3, 0xe3, 0x4f, 0x98, 0xd4, 0x32, 0xf0, 0x46,
-	0xee, 0xb8, 0xb7, 0xdd, 0x9b, 0x28, 0xf9, 0x3b, 0xcd, 0xb1, 0xd3, 0x1e, 0x7d, 0x04, 0x10, 0x97,
-	0x3c, 0x59, 0xcc, 0x05, 0x66, 0x32, 0x68, 0x69, 0xe5, 0x86, 0x56, 0xee, 0x28, 0x9a, 0x61, 0xc6,
-	0xba, 0xb1, 0xad, 0x24, 0x7d, 0x08, 0xd7, 0xf2, 0x48, 0xce, 0x73, 0x8c, 0x52, 0x14, 0xf3, 0x8c,
-	0xf3, 0x1a, 0x45, 0xd0, 0x1e, 0x91, 0xb1, 0xcf, 0xae, 0xe6, 0x91, 0xdc, 0xd3, 0xfc, 0xae, 0xa6,
-	0x67, 0x9e, 0x4f, 0x06, 0xce, 0xcc, 0xf3, 0x9d, 0x81, 0x3b, 0xf3, 0x7c, 0x77, 0xe0, 0x85, 0x5f,
-	0x08, 0xb4, 0xf7, 0xf3, 0x48, 0x60, 0x4a, 0xef, 0x42, 0xdb, 0x98, 0x04, 0x64, 0x44, 0xfe, 0x5d,
-	0xce, 0xb6, 0x94, 0xc8, 0x7e, 0xc2, 0xb9, 0x44, 0x64, 0x5a, 0x74, 0x0b, 0x7a, 0x76, 0x1d, 0x59,
-	0x1c, 0x63, 0xe0, 0x8e, 0xc8, 0xd8, 0x65, 0x60, 0xa8, 0xfd, 0xe2, 0x18, 0x95, 0xc0, 0x48, 0x8d,
-	0xc0, 0x33, 0x02, 0x43, 0x29, 0x41, 0x98, 0x01, 0x7d, 0x55, 0x08, 0x4c, 0x6a, 0x2e, 0xd6, 0xcd,
-	0xf9, 0x6d, 0x82, 0x9f, 0xe4, 0x45, 0x99, 0x0a, 0xac, 0x02, 0x77, 0xe4, 0x8e, 0xbb, 0xec, 0x0c,
-	0xd3, 0x31, 0xb4, 0xa5, 0xce, 0xa1, 0xdd, 0x7a, 0xdb, 0x83, 0xc9, 0xd9, 0xdf, 0x65, 0xf2, 0x31,
-	0xdb, 0x3f, 0x7f, 0x08, 0xe1, 0x0f, 0x02, 0xdd, 0xc6, 0x9f, 0x82, 0x57, 0x45, 0x87, 0xa8, 0xf3,
-	0x77, 0x99, 0xae, 0x15, 0xa7, 0x8c, 0x74, 0xdc, 0x3e, 0xd3, 0x35, 0xbd, 0x03, 0x7d, 0xb9, 0x8a,
-	0x95, 0xf7, 0xf9, 0x80, 0x3d, 0xcb, 0xe9, 0x84, 0x4f, 0xa1, 0x9b, 0x15, 0x25, 0xce, 0x2b, 0x9e,
-	0xa2, 0xdd, 0xe8, 0x56, 0xb3, 0xd1, 0x85, 0x6b, 0xc1, 0xfc, 0xcc, 0x42, 0xfa, 0x1c, 0xfc, 0xb4,
-	0x10, 0x66, 0xa8, 0xa5, 0x87, 0x6e, 0x37, 0x43, 0xff, 0x1f, 0x08, 0xeb, 0xa4, 0x85, 0x50, 0x28,
-	0xfc, 0x4e, 0x60, 0x63, 0x2f, 0x92, 0xf9, 0x07, 0x81, 0x36, 0x4b, 0x00, 0x9d, 0x23, 0x14, 0xb2,
-	0xe0, 0x95, 0x8e, 0xd3, 0x62, 0xa7, 0x90, 0x4e, 0xc1, 0xc9, 0x64, 0xe0, 0xe8, 0x6b, 0xb5, 0xd5,
-	0xd8, 0x5f, 0x18, 0x9f, 0xec, 0xca, 0xd7, 0x55, 0x2d, 0xd6, 0xcc, 0xc9, 0xe4, 0xe6, 0x0c, 0x3a,
-	0x16, 0xd2, 0x01, 0xb8, 0x0b, 0x5c, 0xdb, 0x03, 0x52, 0x25, 0x7d, 0x00, 0xad, 0xa3, 0xa8, 0x5c,
-	0xa1, 0xbd, 0x0f, 0xd7, 0x1b, 0xc3, 0x66, 0x4d, 0xa3, 0x78, 0xe9, 0xbc, 0x20, 0xe1, 0x7d, 0xe8,
-	0xef, 0xac, 0x92, 0x05, 0xd6, 0xe6, 0x5e, 0xd2, 0x9b, 0xd0, 0x8e, 0x35, 0xb6, 0x9e, 0x16, 0x85,
-	0x8f, 0xa1, 0xf5, 0xa6, 0x4a, 0xf1, 0x33, 0xed, 0x03, 0x59, 0xe8, 0x5e, 0x9f, 0x91, 0x85, 0x92,
-	0xf3, 0x2c, 0x93, 0x58, 0xeb, 0xcf, 0x79, 0xcc, 0xa2, 0x9d, 0xbd, 0x9f, 0x27, 0x43, 0xf2, 0xeb,
-	0x64, 0x48, 0x7e, 0x9f, 0x0c, 0xc9, 0xd7, 0x3f, 0xc3, 0x2b, 0x1f, 0x9f, 0x1d, 0x14, 0x75, 0xbe,
-	0x8a, 0x27, 0x09, 0x3f, 0x9c, 0x2e, 0xa3, 0x24, 0x5f, 0xa7, 0x28, 0xce, 0x57, 0x52, 0x24, 0xd3,
-	0x4b, 0x1e, 0x79, 0xdc, 0xd6, 0x8f, 0xf7, 0xc9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x3f,
-	0xaa, 0x91, 0x02, 0x04, 0x00, 0x00,
+	// 697 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xb1, 0x93, 0x38, 0x93, 0x84, 0x86, 0xa5, 0x02, 0x2b, 0x42, 0x69, 0x70, 0x55, 0x29,
+	0x54, 0x90, 0x48, 0x05, 0x01, 0x42, 0xea, 0xa1, 0x05, 0xa2, 0x92, 0x03, 0xa0, 0x2d, 0x27, 0x2e,
+	0x91, 0x7f, 0xc6, 0xb1, 0x89, 0x63, 0x47, 0xbb, 0x9b, 0x8a, 0xf4, 0x09, 0x78, 0x00, 0x0e, 0x9c,
+	0x39, 0xf0, 0x2c, 0x1c, 0x79, 0x04, 0x54, 0x5e, 0x04, 0x79, 0xd7, 0x89, 0xd3, 0x52, 0x0e, 0x91,
+	0x66, 0xbe, 0xf9, 0xbe, 0xd9, 0xcf, 0x9f, 0x37, 0x06, 0x9b, 0x23, 0x3b, 0x43, 0x36, 0x98, 0x4f,
+	0x27, 0x83, 0xd0, 0xe1, 0xa1, 0x60, 0x88, 0xeb, 0xa2, 0x3f, 0x67, 0xa9, 0x48, 0x89, 0xb9, 0xea,
+	0xdb, 0xdb, 0x5e, 0x1c, 0x61, 0x22, 0x06, 0xf3, 0x80, 0x67, 0x3f, 0x35, 0xb7, 0xbf, 0x6b, 0xd0,
+	0x1c, 0x46, 0x31, 0xbe, 0x4d, 0x7d, 0x7c, 0x2f, 0x15, 0x7b, 0x50, 0x4d, 0xdd, 0x4f, 0xe8, 0x09,
+	0x6e, 0x19, 0x5d, 0xbd, 0x57, 0x3f, 0xa8, 0xf7, 0x33, 0xfa, 0x3b, 0x89, 0xd1, 0xd5, 0x8c, 0x3c,
+	0x04, 0x70, 0xe3, 0xd4, 0x9b, 0x8e, 0x19, 0x06, 0xdc, 0x2a, 0x4b, 0x66, 0x53, 0x32, 0x8f, 0x33,
+	0x98, 0x62, 0x40, 0x6b, 0x6e, 0x5e, 0x71, 0xb2, 0x0f, 0xb7, 0x42, 0x87, 0x8f, 0x43, 0x74, 0x7c,
+	0x64, 0xe3, 0x20, 0x4d, 0x05, 0x32, 0xab, 0xd2, 0xd5, 0x7a, 0x26, 0xdd, 0x0a, 0x1d, 0x7e, 0x22,
+	0xf1, 0xa1, 0x84, 0x47, 0x86, 0xa9, 0xb5, 0x4a, 0x23, 0xc3, 0x2c, 0xb5, 0xf4, 0x91, 0x61, 0xea,
+	0x2d, 0xc3, 0xfe, 0xaa, 0x41, 0xe5, 0x34, 0x74, 0x18, 0xfa, 0x64, 0x17, 0x2a, 0x6a, 0x89, 0xa5,
+	0x75, 0xb5, 0xab, 0xe6, 0xf2, 0x51, 0x46, 0xca, 0x8f, 0x28, 0x5d, 0x43, 0x52, 0x23, 0xb2, 0x03,
+	0xf5, 0xdc, 0x0e, 0x8f, 0xce, 0xd1, 0xd2, 0xbb, 0x5a, 0x4f, 0xa7, 0xa0, 0xa0, 0xd3, 0xe8, 0x1c,
+	0x33, 0x82, 0xa2, 0x2a, 0x82, 0xa1, 0x08, 0x0a, 0xca, 0x08, 0xf6, 0x3e, 0xb4, 0x4e, 0x97, 0xb3,
+	0x38, 0x4a, 0xa6, 0x45, 0x7a, 0x77, 0xa0, 0x22, 0x1c, 0x36, 0x41, 0x21, 0xfd, 0xd5, 0x68, 0xde,
+	0xd9, 0x01, 0x90, 0x57, 0x11, 0x43, 0x4f, 0xa4, 0x6c, 0x59, 0xb0, 0xdb, 0x60, 0x7a, 0x61, 0x14,
+	0xfb, 0x0c, 0x13, 0x4b, 0xef, 0xea, 0xbd, 0x1a, 0x5d, 0xf7, 0xa4, 0x07, 0x15, 0x2e, 0x9f, 0x59,
+	0x9e, 0x5c, 0x3f, 0x68, 0xf5, 0xd7, 0xaf, 0x56, 0x65, 0x41, 0xf3, 0xf9, 0x66, 0x60, 0xf6, 0x17,
+	0x1d, 0x6a, 0xc5, 0x7e, 0x02, 0x46, 0xe2, 0xcc, 0x30, 0xf7, 0x22, 0xeb, 0x0c, 0xcb, 0x16, 0xc9,
+	0x68, 0x1a, 0x54, 0xd6, 0xe4, 0x3e, 0x34, 0xf8, 0xc2, 0xcd, 0x76, 0x6f, 0x86, 0x51, 0xcf, 0x31,
+	0x99, 0xc6, 0x13, 0xa8, 0x05, 0x51, 0x8c, 0xe3, 0x24, 0xf5, 0x31, 0x77, 0x74, 0xb7, 0x70, 0x74,
+	0xe9, 0x0a, 0x51, 0x33, 0xc8, 0x5b, 0xf2, 0x0c, 0x4c, 0x3f, 0x62, 0x4a, 0x54, 0x96, 0xa2, 0x7b,
+	0x85, 0xe8, 0xdf, 0x40, 0x68, 0xd5, 0x8f, 0x98, 0x14, 0x1e, 0x42, 0x83, 0xab, 0x6c, 0x95, 0xb8,
+	0x22, 0xc5, 0xed, 0x8d, 0x0c, 0xae, 0x24, 0x4f, 0xeb, 0xbc, 0x40, 0xc8, 0x4b, 0x00, 0x47, 0x08,
+	0x16, 0xb9, 0x0b, 0x81, 0xdc, 0xaa, 0xca, 0xdb, 0xb9, 0x5b, 0x88, 0xd7, 0xaa, 0xfe, 0xd1, 0x9a,
+	0xf5, 0x3a, 0x11, 0x6c, 0x49, 0x37, 0x64, 0xed, 0x43, 0xd8, 0xba, 0x32, 0x26, 0x2d, 0xd0, 0xa7,
+	0xb8, 0xcc, 0xf3, 0xcc, 0x4a, 0xb2, 0x0d, 0xe5, 0x33, 0x27, 0x5e, 0xa0, 0xcc, 0xb3, 0x46, 0x55,
+	0xf3, 0xa2, 0xf4, 0x5c, 0xb3, 0x7f, 0x68, 0xd0, 0x3c, 0x71, 0x78, 0xf8, 0x81, 0x61, 0xfe, 0x3a,
+	0x2c, 0xa8, 0x9e, 0x21, 0xe3, 0x51, 0x9a, 0xc8, 0x0d, 0x65, 0xba, 0x6a, 0xc9, 0x00, 0x4a, 0x01,
+	0xb7, 0x4a, 0xd2, 0xe7, 0x4e, 0xe1, 0xf3, 0x92, 0xbc, 0x3f, 0xcc, 0x3d, 0x96, 0x02, 0xde, 0x1e,
+	0x41, 0x75, 0xf8, 0x5f, 0x4f, 0x0f, 0x36, 0x3d, 0xd5, 0x0f, 0x6e, 0x5f, 0xf3, 0xe0, 0x9b, 0x46,
+	0x11, 0x1a, 0xc7, 0x0b, 0x6f, 0x8a, 0x42, 0xfd, 0x0d, 0xb3, 0x3b, 0xec, 0xca, 0x7e, 0x75, 0x87,
+	0x55, 0x97, 0xe1, 0x41, 0xca, 0x66, 0x8e, 0x90, 0x7b, 0x9b, 0x34, 0xef, 0xc8, 0x1e, 0xdc, 0xcc,
+	0x0e, 0x18, 0x3b, 0xf1, 0x24, 0x65, 0x91, 0x08, 0x67, 0xf2, 0xfe, 0xd4, 0x68, 0x33, 0x43, 0x8f,
+	0x56, 0xa0, 0xfd, 0x08, 0xca, 0x6f, 0x12, 0x1f, 0x3f, 0x93, 0x06, 0x68, 0x53, 0xb9, 0xba, 0x41,
+	0xb5, 0x69, 0xb6, 0x35, 0x0d, 0x02, 0x8e, 0x6a, 0xab, 0x41, 0xf3, 0xee, 0xf8, 0xe4, 0xe7, 0x45,
+	0x47, 0xfb, 0x75, 0xd1, 0xd1, 0x7e, 0x5f, 0x74, 0xb4, 0x6f, 0x7f, 0x3a, 0x37, 0x3e, 0x3e, 0x9d,
+	0x44, 0x22, 0x5c, 0xb8, 0x7d, 0x2f, 0x9d, 0x0d, 0xe6, 0x8e, 0x17, 0x2e, 0x7d, 0x64, 0x9b, 0x15,
+	0x67, 0xde, 0xe0, 0x9a, 0x4f, 0xa2, 0x5b, 0x91, 0x9f, 0xba, 0xc7, 0x7f, 0x03, 0x00, 0x00, 0xff,
+	0xff, 0xed, 0x50, 0x6c, 0xd3, 0x30, 0x05, 0x00, 0x00,
 }
 
 func (m *FileNodeProto) Marshal() (dAtA []byte, err error) {
@@ -677,6 +788,40 @@ func (m *Shared) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SymlinkNodeProto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymlinkNodeProto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymlinkNodeProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintHashtree(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DirectoryNodeProto) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -749,6 +894,37 @@ func (m *NodeProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintHashtree(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintHashtree(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintHashtree(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.SymlinkNode != nil {
+		{
+			size, err := m.SymlinkNode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHashtree(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.DirNode != nil {
 		{
 			size, err := m.DirNode.MarshalToSizedBuffer(dAtA[:i])
@@ -877,6 +1053,18 @@ func (m *BucketHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.HashAlgorithm) > 0 {
+		i -= len(m.HashAlgorithm)
+		copy(dAtA[i:], m.HashAlgorithm)
+		i = encodeVarintHashtree(dAtA, i, uint64(len(m.HashAlgorithm)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Format != 0 {
+		i = encodeVarintHashtree(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Bucket) > 0 {
 		i -= len(m.Bucket)
 		copy(dAtA[i:], m.Bucket)
@@ -990,6 +1178,22 @@ func (m *Shared) Size() (n int) {
 	return n
 }
 
+func (m *SymlinkNodeProto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovHashtree(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DirectoryNodeProto) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1037,6 +1241,18 @@ func (m *NodeProto) Size() (n int) {
 		l = m.DirNode.Size()
 		n += 1 + l + sovHashtree(uint64(l))
 	}
+	if m.SymlinkNode != nil {
+		l = m.SymlinkNode.Size()
+		n += 1 + l + sovHashtree(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovHashtree(uint64(len(k))) + 1 + len(v) + sovHashtree(uint64(len(v)))
+			n += mapEntrySize + 1 + sovHashtree(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1081,6 +1297,13 @@ func (m *BucketHeader) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovHashtree(uint64(l))
 	}
+	if m.Format != 0 {
+		n += 1 + sovHashtree(uint64(m.Format))
+	}
+	l = len(m.HashAlgorithm)
+	if l > 0 {
+		n += 1 + l + sovHashtree(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1235,10 +1458,7 @@ func (m *FileNodeProto) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthHashtree
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthHashtree
 			}
 			if (iNdEx + skippy) > l {
@@ -1399,10 +1619,90 @@ func (m *Shared) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthHashtree
 			}
-			if (iNdEx + skippy) < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymlinkNodeProto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHashtree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymlinkNodeProto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymlinkNodeProto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHashtree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthHashtree
 			}
 			if (iNdEx + skippy) > l {
@@ -1521,10 +1821,7 @@ func (m *DirectoryNodeProto) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthHashtree
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthHashtree
 			}
 			if (iNdEx + skippy) > l {
@@ -1726,16 +2023,176 @@ func (m *NodeProto) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SymlinkNode == nil {
+				m.SymlinkNode = &SymlinkNodeProto{}
+			}
+			if err := m.SymlinkNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHashtree
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHashtree
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthHashtree
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthHashtree
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHashtree
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthHashtree
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthHashtree
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipHashtree(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthHashtree
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHashtree(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthHashtree
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthHashtree
 			}
 			if (iNdEx + skippy) > l {
@@ -1917,7 +2374,7 @@ func (m *HashTreeProto) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthHashtree
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -1934,10 +2391,7 @@ func (m *HashTreeProto) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthHashtree
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthHashtree
 			}
 			if (iNdEx + skippy) > l {
@@ -2014,16 +2468,64 @@ func (m *BucketHeader) Unmarshal(dAtA []byte) error {
 			}
 			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashAlgorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHashtree(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthHashtree
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthHashtree
 			}
 			if (iNdEx + skippy) > l {
@@ -2127,10 +2629,7 @@ func (m *Index) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthHashtree
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthHashtree
 			}
 			if (iNdEx + skippy) > l {
@@ -2149,6 +2648,7 @@ func (m *Index) Unmarshal(dAtA []byte) error {
 func skipHashtree(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -2180,10 +2680,8 @@ func skipHashtree(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -2204,55 +2702,30 @@ func skipHashtree(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthHashtree
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthHashtree
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowHashtree
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipHashtree(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthHashtree
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHashtree
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHashtree
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthHashtree = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowHashtree   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthHashtree        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHashtree          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHashtree = fmt.Errorf("proto: unexpected end of group")
 )
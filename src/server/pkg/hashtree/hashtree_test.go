@@ -1,9 +1,12 @@
 package hashtree
 
 import (
+	"archive/tar"
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"testing"
 
 	bolt "github.com/coreos/bbolt"
@@ -11,6 +14,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"golang.org/x/sync/errgroup"
 )
 
 // obj parses a string as an Object
@@ -460,6 +464,81 @@ func TestGlobFile(t *testing.T) {
 }
 
 // Test that Walk() works
+func TestSubtree(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/dir/foo", obj(`hash:"20c27"`), 1))
+	require.NoError(t, h.PutFile("/dir/sub/bar", obj(`hash:"ebc57"`), 1))
+	require.NoError(t, h.PutFile("/other", obj(`hash:"8e02c"`), 1))
+	require.NoError(t, h.Hash())
+
+	sub, err := h.Subtree("/dir")
+	require.NoError(t, err)
+	// The subtree is re-rooted at /dir, with hashes and sizes preserved
+	require.Equal(t, getT(t, h, "/dir").Hash, getT(t, sub, "").Hash)
+	require.Equal(t, int64(2), sub.FSSize())
+	require.Equal(t, getT(t, h, "/dir/foo").Hash, getT(t, sub, "/foo").Hash)
+	require.Equal(t, getT(t, h, "/dir/sub/bar").Hash, getT(t, sub, "/sub/bar").Hash)
+	_, err = sub.Get("/other")
+	require.YesError(t, err)
+	require.Equal(t, PathNotFound, Code(err))
+
+	// Extracting the subtree at a file or a nonexistent path fails
+	_, err = h.Subtree("/dir/foo")
+	require.YesError(t, err)
+	require.Equal(t, PathConflict, Code(err))
+	_, err = h.Subtree("/nonexistent")
+	require.YesError(t, err)
+	require.Equal(t, PathNotFound, Code(err))
+}
+
+func TestGlobExclude(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/data/a.csv", obj(`hash:"20c27"`), 1))
+	require.NoError(t, h.PutFile("/data/b.tmp", obj(`hash:"ebc57"`), 1))
+	require.NoError(t, h.PutFile("/data/sub/c.csv", obj(`hash:"8e02c"`), 1))
+	require.NoError(t, h.PutFile("/data/sub/d.tmp", obj(`hash:"4ab7d"`), 1))
+	require.NoError(t, h.Hash())
+	var paths []string
+	require.NoError(t, h.GlobExclude("/data/**", []string{"**/*.tmp"}, func(path string, node *NodeProto) error {
+		paths = append(paths, path)
+		return nil
+	}))
+	require.Equal(t, []string{"/data/a.csv", "/data/sub", "/data/sub/c.csv"}, paths)
+	// A malformed exclusion errors like a malformed pattern
+	err := h.GlobExclude("/data/**", []string{"["}, func(string, *NodeProto) error { return nil })
+	require.YesError(t, err)
+	require.Equal(t, MalformedGlob, Code(err))
+}
+
+func TestGlobPaged(t *testing.T) {
+	h := newHashTree(t)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, h.PutFile(fmt.Sprintf("/dir/file-%d", i), obj(`hash:"20c27"`), 1))
+	}
+	require.NoError(t, h.Hash())
+	var all []string
+	require.NoError(t, h.Glob("/dir/*", func(path string, node *NodeProto) error {
+		all = append(all, path)
+		return nil
+	}))
+	require.Equal(t, 10, len(all))
+	// Paging through the matches yields the same paths in the same order
+	var paged []string
+	start := ""
+	for {
+		next, err := h.GlobPaged("/dir/*", start, 3, func(path string, node *NodeProto) error {
+			paged = append(paged, path)
+			return nil
+		})
+		require.NoError(t, err)
+		if next == "" {
+			break
+		}
+		start = next
+	}
+	require.Equal(t, all, paged)
+}
+
 func TestWalk(t *testing.T) {
 	h := newHashTree(t)
 	require.NoError(t, h.PutFile("/foo", obj(`hash:"20c27"`), 1))
@@ -488,6 +567,481 @@ func TestWalk(t *testing.T) {
 }
 
 // Test that HashTree methods return the right error codes
+func TestSymlink(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/dir/file", obj(`hash:"20c27"`), 1))
+	require.NoError(t, h.PutSymlink("/dir/absolute", "/dir/file"))
+	require.NoError(t, h.PutSymlink("/dir/relative", "file"))
+	require.NoError(t, h.PutSymlink("/dir/chained", "relative"))
+	require.NoError(t, h.Hash())
+
+	// Get follows links (absolute, relative, and chained) to the file
+	fileNode := getT(t, h, "/dir/file")
+	for _, link := range []string{"/dir/absolute", "/dir/relative", "/dir/chained"} {
+		node, err := h.Get(link)
+		require.NoError(t, err)
+		require.Equal(t, fileNode.Hash, node.Hash)
+		require.NotNil(t, node.FileNode)
+	}
+
+	// List returns the link nodes themselves
+	nodes, err := h.ListAll("/dir")
+	require.NoError(t, err)
+	links := 0
+	for _, node := range nodes {
+		if node.SymlinkNode != nil {
+			links++
+		}
+	}
+	require.Equal(t, 3, links)
+
+	// A dangling link errors with PathNotFound, a cycle with TooManySymlinks
+	require.NoError(t, h.PutSymlink("/dir/dangling", "nonexistent"))
+	require.NoError(t, h.PutSymlink("/cycle/a", "b"))
+	require.NoError(t, h.PutSymlink("/cycle/b", "a"))
+	require.NoError(t, h.Hash())
+	_, err = h.Get("/dir/dangling")
+	require.YesError(t, err)
+	require.Equal(t, PathNotFound, Code(err))
+	_, err = h.Get("/cycle/a")
+	require.YesError(t, err)
+	require.Equal(t, TooManySymlinks, Code(err))
+
+	// Re-pointing a link changes the hashes above it
+	oldRootHash := getT(t, h, "").Hash
+	require.NoError(t, h.PutSymlink("/dir/absolute", "/dir/dangling"))
+	require.NoError(t, h.Hash())
+	require.NotEqual(t, oldRootHash, getT(t, h, "").Hash)
+}
+
+func TestWalkSkipDir(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/dir/skipped/foo", obj(`hash:"20c27"`), 1))
+	require.NoError(t, h.PutFile("/dir/skipped/sub/bar", obj(`hash:"ebc57"`), 1))
+	require.NoError(t, h.PutFile("/dir/walked/buzz", obj(`hash:"8e02c"`), 1))
+	require.NoError(t, h.Hash())
+	var paths []string
+	require.NoError(t, h.Walk("/", func(path string, node *NodeProto) error {
+		if path == "/dir/skipped" {
+			return ErrSkipDir
+		}
+		paths = append(paths, path)
+		return nil
+	}))
+	require.Equal(t, []string{"/", "/dir", "/dir/walked", "/dir/walked/buzz"}, paths)
+}
+
+func TestAttributes(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/dir/file", obj(`hash:"20c27"`), 1))
+	require.NoError(t, h.Hash())
+	plainRootHash := getT(t, h, "").Hash
+
+	// Setting attributes changes the node's hash and propagates to the root
+	require.NoError(t, h.SetAttributes("/dir/file", map[string]string{"content-type": "text/csv"}))
+	require.NoError(t, h.Hash())
+	require.Equal(t, "text/csv", getT(t, h, "/dir/file").Attributes["content-type"])
+	require.NotEqual(t, plainRootHash, getT(t, h, "").Hash)
+
+	// Setting a key to "" deletes it, restoring the original hashes
+	require.NoError(t, h.SetAttributes("/dir/file", map[string]string{"content-type": ""}))
+	require.NoError(t, h.Hash())
+	require.Nil(t, getT(t, h, "/dir/file").Attributes)
+	require.Equal(t, plainRootHash, getT(t, h, "").Hash)
+
+	// Attributes on a missing path error with PathNotFound
+	err := h.SetAttributes("/nonexistent", map[string]string{"k": "v"})
+	require.Equal(t, PathNotFound, Code(err))
+
+	// Attributes survive serialization
+	require.NoError(t, h.SetAttributes("/dir/file", map[string]string{"owner": "alice"}))
+	require.NoError(t, h.Hash())
+	var buf bytes.Buffer
+	require.NoError(t, h.Serialize(&buf))
+	h2, err := DeserializeDBHashTree("", &buf)
+	require.NoError(t, err)
+	require.Equal(t, "alice", getT(t, h2, "/dir/file").Attributes["owner"])
+
+	// Attributes survive merging; on a key conflict the first tree's value wins
+	lBuf, rBuf, mergedBuf := &bytes.Buffer{}, &bytes.Buffer{}, &bytes.Buffer{}
+	writeTree := func(w *Writer, fileHash string, attrs map[string]string) {
+		writeMergeNode(w, "/", "20c2", 1)
+		writeMergeNode(w, "/dir", "20c2", 1)
+		n := mergeNode("/dir/file", fileHash, 1)
+		n.nodeProto.FileNode = &FileNodeProto{BlockRefs: blocks(``)}
+		n.nodeProto.Attributes = attrs
+		require.NoError(t, w.Write(n))
+	}
+	writeTree(NewWriter(lBuf), "6c30", map[string]string{"owner": "alice", "tag": "raw"})
+	writeTree(NewWriter(rBuf), "7230", map[string]string{"owner": "bob", "source": "s3"})
+	require.NoError(t, Merge(NewWriter(mergedBuf),
+		[]*Reader{NewReader(bytes.NewReader(lBuf.Bytes()), nil), NewReader(bytes.NewReader(rBuf.Bytes()), nil)}))
+	mr := NewReader(mergedBuf, nil)
+	var mergedFile *NodeProto
+	for {
+		n, err := mr.Read()
+		if err != nil {
+			break // EOF
+		}
+		if s(n.k) == "/dir/file" {
+			mergedFile = &NodeProto{}
+			require.NoError(t, mergedFile.Unmarshal(n.v))
+		}
+	}
+	require.NotNil(t, mergedFile)
+	require.Equal(t, "alice", mergedFile.Attributes["owner"])
+	require.Equal(t, "raw", mergedFile.Attributes["tag"])
+	require.Equal(t, "s3", mergedFile.Attributes["source"])
+}
+
+func TestTreeFormatV2(t *testing.T) {
+	newTree := func(format uint32, algorithm string) HashTree {
+		h, err := NewDBHashTreeFormat("", format, algorithm)
+		require.NoError(t, err)
+		require.NoError(t, h.PutFile("/foo", obj(`hash:"20c27"`), 1))
+		require.NoError(t, h.PutFile("/dir/bar", obj(`hash:"ebc57"`), 1))
+		require.NoError(t, h.Hash())
+		return h
+	}
+	v1 := newTree(FormatV1, "")
+	v2 := newTree(FormatV2, HashAlgorithmSHA256)
+	v2b := newTree(FormatV2, HashAlgorithmBLAKE2b)
+
+	// The same content hashes differently under each format/algorithm, but
+	// deterministically within one
+	require.NotEqual(t, getT(t, v1, "").Hash, getT(t, v2, "").Hash)
+	require.NotEqual(t, getT(t, v2, "").Hash, getT(t, v2b, "").Hash)
+	require.Equal(t, getT(t, v2b, "").Hash, getT(t, newTree(FormatV2, HashAlgorithmBLAKE2b), "").Hash)
+
+	// The format survives serialization: a deserialized v2 tree keeps its
+	// hashes and keeps hashing new content the v2 way
+	var buf bytes.Buffer
+	require.NoError(t, v2b.Serialize(&buf))
+	h2, err := DeserializeDBHashTree("", &buf)
+	require.NoError(t, err)
+	requireSame(t, v2b, h2)
+	require.NoError(t, h2.PutFile("/buzz", obj(`hash:"8e02c"`), 1))
+	require.NoError(t, h2.Hash())
+	require.NoError(t, v2b.PutFile("/buzz", obj(`hash:"8e02c"`), 1))
+	require.NoError(t, v2b.Hash())
+	require.Equal(t, getT(t, v2b, "").Hash, getT(t, h2, "").Hash)
+
+	// Unknown formats and algorithms are rejected up front
+	_, err = NewDBHashTreeFormat("", 42, "")
+	require.YesError(t, err)
+	_, err = NewDBHashTreeFormat("", FormatV2, "md5")
+	require.YesError(t, err)
+	_, err = NewDBHashTreeFormat("", FormatV1, HashAlgorithmBLAKE2b)
+	require.YesError(t, err)
+}
+
+func TestGlobCache(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/foo", obj(`hash:"20c27"`), 1))
+	require.NoError(t, h.Hash())
+	nop := func(string, *NodeProto) error { return nil }
+
+	// The first evaluation of a pattern compiles it; repeats hit the cache
+	pattern := "/glob-cache-test-*"
+	_, misses0 := GlobCacheStats()
+	require.NoError(t, h.Glob(pattern, nop))
+	hits1, misses1 := GlobCacheStats()
+	require.Equal(t, misses0+1, misses1)
+	require.NoError(t, h.Glob(pattern, nop))
+	hits2, misses2 := GlobCacheStats()
+	require.Equal(t, misses1, misses2)
+	require.Equal(t, hits1+1, hits2)
+
+	// Malformed patterns still error with MalformedGlob
+	require.Equal(t, MalformedGlob, Code(h.Glob("[", nop)))
+}
+
+func TestTracer(t *testing.T) {
+	var events []string
+	SetTracer(func(op, path string) {
+		events = append(events, fmt.Sprintf("%s %s", op, path))
+	})
+	defer SetTracer(nil)
+
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/foo", obj(`hash:"20c27"`), 1))
+	require.NoError(t, h.Hash())
+	_, err := h.Get("/foo")
+	require.NoError(t, err)
+	require.NoError(t, h.Glob("/*", func(string, *NodeProto) error { return nil }))
+	require.Equal(t, []string{"putFile /foo", "get /foo", "glob /*"}, events)
+
+	// With the tracer removed, operations emit nothing
+	SetTracer(nil)
+	events = nil
+	require.NoError(t, h.PutFile("/bar", obj(`hash:"ebc57"`), 1))
+	require.Nil(t, events)
+}
+
+func TestTarRoundTrip(t *testing.T) {
+	// A fake object store: file contents keyed by path
+	contents := map[string][]byte{
+		"/dir/foo":     []byte("foo data"),
+		"/dir/sub/bar": []byte("bar data"),
+		"/buzz":        []byte("buzz data"),
+	}
+	h := newHashTree(t)
+	for path, data := range contents {
+		require.NoError(t, h.PutFile(path, obj(fmt.Sprintf(`hash:"%x"`, sha256.Sum256(data))), int64(len(data))))
+	}
+	require.NoError(t, h.PutSymlink("/link", "buzz"))
+	require.NoError(t, h.PutDir("/empty"))
+	require.NoError(t, h.Hash())
+
+	var buf bytes.Buffer
+	require.NoError(t, ExportTar(h, &buf, func(path string, node *NodeProto, w io.Writer) error {
+		_, err := w.Write(contents[path])
+		return err
+	}))
+
+	h2 := newHashTree(t)
+	imported := make(map[string][]byte)
+	require.NoError(t, ImportTar(h2, &buf, func(path string, r io.Reader) ([]*pfs.Object, int64, error) {
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, 0, err
+		}
+		imported[path] = data
+		return obj(fmt.Sprintf(`hash:"%x"`, sha256.Sum256(data))), int64(len(data)), nil
+	}))
+	require.NoError(t, h2.Hash())
+	require.Equal(t, contents, imported)
+	requireSame(t, h, h2)
+	// List returns link nodes themselves (Get would resolve them)
+	nodes, err := h2.ListAll("/")
+	require.NoError(t, err)
+	foundLink := false
+	for _, node := range nodes {
+		if node.Name == "link" {
+			foundLink = true
+			require.Equal(t, "buzz", node.SymlinkNode.Target)
+		}
+	}
+	require.True(t, foundLink)
+	require.NotNil(t, getT(t, h2, "/empty").DirNode)
+
+	// Hard links only come in from external tar streams (ExportTar writes
+	// each hard-linked path as a regular file); their linknames are
+	// archive-root-relative, and they must import as hard links
+	var linkBuf bytes.Buffer
+	tw := tar.NewWriter(&linkBuf)
+	data := []byte("orig data")
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "dir/orig", Typeflag: tar.TypeReg, Size: int64(len(data))}))
+	_, err = tw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "dir/lnk", Typeflag: tar.TypeLink, Linkname: "dir/orig"}))
+	require.NoError(t, tw.Close())
+	h3 := newHashTree(t)
+	require.NoError(t, ImportTar(h3, &linkBuf, func(path string, r io.Reader) ([]*pfs.Object, int64, error) {
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, 0, err
+		}
+		return obj(fmt.Sprintf(`hash:"%x"`, sha256.Sum256(data))), int64(len(data)), nil
+	}))
+	require.NoError(t, h3.Hash())
+	require.Equal(t, getT(t, h3, "/dir/orig").FileNode.Objects, getT(t, h3, "/dir/lnk").FileNode.Objects)
+	// an append through one path updates the other, proving the entry was
+	// imported as a hard link rather than a copy
+	require.NoError(t, h3.PutFile("/dir/orig", obj(`hash:"20c27"`), 1))
+	require.NoError(t, h3.Hash())
+	require.Equal(t, 2, len(getT(t, h3, "/dir/lnk").FileNode.Objects))
+	require.Equal(t, getT(t, h3, "/dir/orig").FileNode.Objects, getT(t, h3, "/dir/lnk").FileNode.Objects)
+}
+
+func TestStats(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/empty", obj(`hash:"20c27"`), 0))
+	require.NoError(t, h.PutFile("/dir/small", obj(`hash:"ebc57"`), 3))
+	require.NoError(t, h.PutFile("/dir/sub/big", obj(`hash:"8e02c"`, `hash:"4ab7d"`), 1000))
+	require.NoError(t, h.PutSymlink("/link", "empty"))
+	require.NoError(t, h.Hash())
+
+	stats, err := h.Stats()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), stats.FileCount)
+	require.Equal(t, int64(3), stats.DirCount) // "/", "/dir", "/dir/sub"
+	require.Equal(t, int64(1), stats.SymlinkCount)
+	require.Equal(t, int64(3), stats.MaxDepth) // /dir/sub/big
+	require.Equal(t, int64(4), stats.ObjectCount)
+	require.Equal(t, int64(1), stats.SizeHistogram[0])  // empty file
+	require.Equal(t, int64(1), stats.SizeHistogram[2])  // 3 bytes => [2,4)
+	require.Equal(t, int64(1), stats.SizeHistogram[10]) // 1000 bytes => [512,1024)
+}
+
+func TestUnorderedConcurrent(t *testing.T) {
+	// Building an Unordered tree from many goroutines must serialize to the
+	// same stream as building it from one
+	sequential, concurrent := NewUnordered(""), NewUnordered("")
+	for i := 0; i < 100; i++ {
+		sequential.PutFile(fmt.Sprintf("/dir-%d/file-%03d", i%5, i), []byte{byte(i)}, 1, blocks(``)...)
+	}
+	var eg errgroup.Group
+	for i := 0; i < 100; i++ {
+		i := i
+		eg.Go(func() error {
+			concurrent.PutFile(fmt.Sprintf("/dir-%d/file-%03d", i%5, i), []byte{byte(i)}, 1, blocks(``)...)
+			return nil
+		})
+	}
+	require.NoError(t, eg.Wait())
+	sequentialBuf, concurrentBuf := &bytes.Buffer{}, &bytes.Buffer{}
+	require.NoError(t, sequential.Ordered().Serialize(sequentialBuf))
+	require.NoError(t, concurrent.Ordered().Serialize(concurrentBuf))
+	require.Equal(t, sequentialBuf, concurrentBuf)
+}
+
+func TestPutFiles(t *testing.T) {
+	// A batch of puts must produce exactly the same tree as the equivalent
+	// sequence of PutFile calls
+	var ops []PutFileOp
+	sequential := newHashTree(t)
+	for i := 0; i < 100; i++ {
+		path := fmt.Sprintf("/dir-%d/file-%03d", i%3, i)
+		objects := obj(fmt.Sprintf(`hash:"%04x"`, i))
+		ops = append(ops, PutFileOp{Path: path, Objects: objects, Size: int64(i)})
+		require.NoError(t, sequential.PutFile(path, objects, int64(i)))
+	}
+	// Appending to a file already written in the same batch works too
+	ops = append(ops, PutFileOp{Path: "/dir-0/file-000", Objects: obj(`hash:"20c27"`), Size: 7})
+	require.NoError(t, sequential.PutFile("/dir-0/file-000", obj(`hash:"20c27"`), 7))
+	require.NoError(t, sequential.Hash())
+
+	batched := newHashTree(t)
+	require.NoError(t, batched.PutFiles(ops))
+	require.NoError(t, batched.Hash())
+	requireSame(t, sequential, batched)
+	problems, err := batched.Validate()
+	require.NoError(t, err)
+	require.Nil(t, problems)
+
+	// A batch write through one side of a hard link updates the whole link
+	// group, same as PutFile
+	linked := newHashTree(t)
+	require.NoError(t, linked.PutFile("/orig", obj(`hash:"20c27"`), 1))
+	require.NoError(t, linked.PutHardLink("/dir/link", "/orig"))
+	require.NoError(t, linked.PutFiles([]PutFileOp{{Path: "/dir/link", Objects: obj(`hash:"ebc57"`), Size: 1}}))
+	require.NoError(t, linked.Hash())
+	require.Equal(t, 2, len(getT(t, linked, "/orig").FileNode.Objects))
+	require.Equal(t, getT(t, linked, "/orig").FileNode.Objects, getT(t, linked, "/dir/link").FileNode.Objects)
+	require.Equal(t, int64(2), getT(t, linked, "/dir").SubtreeSize)
+	require.Equal(t, int64(4), getT(t, linked, "").SubtreeSize)
+	problems, err = linked.Validate()
+	require.NoError(t, err)
+	require.Nil(t, problems)
+
+	// Conflicts with existing non-files are caught
+	h := newHashTree(t)
+	require.NoError(t, h.PutDir("/dir"))
+	require.Equal(t, PathConflict, Code(h.PutFiles([]PutFileOp{{Path: "/dir", Objects: obj(`hash:"20c27"`), Size: 1}})))
+	require.NoError(t, h.PutFile("/file", obj(`hash:"20c27"`), 1))
+	require.Equal(t, PathConflict, Code(h.PutFiles([]PutFileOp{{Path: "/file/child", Objects: obj(`hash:"ebc57"`), Size: 1}})))
+}
+
+func TestCopyFile(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/src/foo", obj(`hash:"20c27"`), 5))
+	require.NoError(t, h.PutFile("/src/sub/bar", obj(`hash:"ebc57"`), 3))
+	require.NoError(t, h.PutFile("/other", obj(`hash:"8e02c"`), 1))
+	require.NoError(t, h.Hash())
+
+	// Copying a directory duplicates the whole subtree and fixes up sizes
+	require.NoError(t, h.CopyFile("/src", "/copied/dst"))
+	require.NoError(t, h.Hash())
+	require.Equal(t, getT(t, h, "/src/foo").FileNode.Objects, getT(t, h, "/copied/dst/foo").FileNode.Objects)
+	require.Equal(t, getT(t, h, "/src/sub/bar").Hash, getT(t, h, "/copied/dst/sub/bar").Hash)
+	require.Equal(t, getT(t, h, "/src").Hash, getT(t, h, "/copied/dst").Hash)
+	require.Equal(t, int64(8), getT(t, h, "/copied").SubtreeSize)
+	require.Equal(t, int64(17), getT(t, h, "").SubtreeSize)
+	problems, err := h.Validate()
+	require.NoError(t, err)
+	require.Nil(t, problems)
+
+	// Copying a single file works too
+	require.NoError(t, h.CopyFile("/other", "/src/other-copy"))
+	require.NoError(t, h.Hash())
+	require.Equal(t, getT(t, h, "/other").Hash, getT(t, h, "/src/other-copy").Hash)
+
+	// Copying over an existing path or into the source itself is a conflict;
+	// copying a missing path is PathNotFound
+	require.Equal(t, PathConflict, Code(h.CopyFile("/other", "/src/foo")))
+	require.Equal(t, PathConflict, Code(h.CopyFile("/src", "/src/sub/cycle")))
+	require.Equal(t, PathConflict, Code(h.CopyFile("/", "/root-copy")))
+	require.Equal(t, PathNotFound, Code(h.CopyFile("/nonexistent", "/dst")))
+}
+
+func TestValidate(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/dir/foo", obj(`hash:"20c27"`), 5))
+	require.NoError(t, h.PutFile("/dir/bar", obj(`hash:"ebc57"`), 3))
+
+	// A tree is valid both before Hash() (pending changes are exempt from the
+	// size and hash checks) and after
+	problems, err := h.Validate()
+	require.NoError(t, err)
+	require.Nil(t, problems)
+	require.NoError(t, h.Hash())
+	problems, err = h.Validate()
+	require.NoError(t, err)
+	require.Nil(t, problems)
+
+	// Corrupt the tree directly in bolt: give the root a bogus size, flip a
+	// byte of a file's hash, and insert a node whose parent doesn't exist
+	db := h.(*dbHashTree)
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		root, err := get(tx, "")
+		if err != nil {
+			return err
+		}
+		root.SubtreeSize += 100
+		rootData, err := root.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := fs(tx).Put(b(""), rootData); err != nil {
+			return err
+		}
+		foo, err := get(tx, "/dir/foo")
+		if err != nil {
+			return err
+		}
+		foo.Hash[0]++
+		fooData, err := foo.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := fs(tx).Put(b("/dir/foo"), fooData); err != nil {
+			return err
+		}
+		ghost := &NodeProto{
+			Name:        "file",
+			SubtreeSize: 1,
+			FileNode:    &FileNodeProto{Objects: obj(`hash:"8e02c"`)},
+		}
+		ghostData, err := ghost.Marshal()
+		if err != nil {
+			return err
+		}
+		return fs(tx).Put(b("/ghost/file"), ghostData)
+	}))
+
+	problems, err = h.Validate()
+	require.NoError(t, err)
+	paths := make(map[string]bool)
+	for _, p := range problems {
+		paths[p.Path] = true
+		require.True(t, len(p.String()) > 0)
+	}
+	require.True(t, paths[""], "expected a problem at the root (bad size)")
+	require.True(t, paths["/dir/foo"], "expected a problem at /dir/foo (bad hash)")
+	require.True(t, paths["/ghost/file"], "expected a problem at /ghost/file (orphan)")
+}
+
 func TestErrorCode(t *testing.T) {
 	require.Equal(t, OK, Code(nil))
 	require.Equal(t, Unknown, Code(fmt.Errorf("external error")))
@@ -590,6 +1144,59 @@ func TestDiff(t *testing.T) {
 	require.Equal(t, 0, len(oldFiles))
 }
 
+func TestDiffRenames(t *testing.T) {
+	old := newHashTree(t)
+	require.NoError(t, old.PutFile("/dir-a/moved", obj(`hash:"20c27"`), 1))
+	require.NoError(t, old.PutFile("/dir-a/deleted", obj(`hash:"ebc57"`), 1))
+	require.NoError(t, old.Hash())
+	new := newHashTree(t)
+	require.NoError(t, new.PutFile("/dir-b/moved", obj(`hash:"20c27"`), 1))
+	require.NoError(t, new.PutFile("/dir-b/added", obj(`hash:"8e02c"`), 1))
+	require.NoError(t, new.Hash())
+	renames := make(map[string]string)
+	var added, removed []string
+	require.NoError(t, new.DiffRenames(old, "", "", -1, func(path string, node *NodeProto, isNew bool, renamedFrom string) error {
+		if node.nodetype() != file {
+			return nil
+		}
+		switch {
+		case renamedFrom != "":
+			renames[path] = renamedFrom
+		case isNew:
+			added = append(added, path)
+		default:
+			removed = append(removed, path)
+		}
+		return nil
+	}))
+	require.Equal(t, map[string]string{"dir-b/moved": "dir-a/moved"}, renames)
+	require.Equal(t, []string{"dir-b/added"}, added)
+	require.Equal(t, []string{"dir-a/deleted"}, removed)
+}
+
+func TestDiffStats(t *testing.T) {
+	old := newHashTree(t)
+	require.NoError(t, old.PutFile("/dir/removed", obj(`hash:"20c27"`), 2))
+	require.NoError(t, old.PutFile("/dir/modified", obj(`hash:"ebc57"`), 1))
+	require.NoError(t, old.Hash())
+	new := newHashTree(t)
+	require.NoError(t, new.PutFile("/dir/modified", obj(`hash:"8e02c"`), 4))
+	require.NoError(t, new.PutFile("/dir/added", obj(`hash:"4ab7d"`), 8))
+	require.NoError(t, new.PutFile("/root-added", obj(`hash:"413e7"`), 16))
+	require.NoError(t, new.Hash())
+	stats, err := new.DiffStats(old, "", "", -1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(stats))
+	require.Equal(t, &DiffStat{
+		FilesAdded:    1,
+		FilesRemoved:  1,
+		FilesModified: 1,
+		BytesAdded:    8 + 3,
+		BytesRemoved:  2,
+	}, stats["/dir"])
+	require.Equal(t, &DiffStat{FilesAdded: 1, BytesAdded: 16}, stats["/"])
+}
+
 func TestChildIterator(t *testing.T) {
 	h := newHashTree(t)
 	require.NoError(t, h.PutFile("a/1", obj(`hash:"23ea6"`), 1))
@@ -726,3 +1333,1046 @@ func TestMergeFiles(t *testing.T) {
 
 	require.Equal(t, expectedBuf, resultBuf)
 }
+
+func TestDiffMany(t *testing.T) {
+	// Three "commits" of the same repo: old1 -> old2 -> new
+	old1, old2, new := newHashTree(t), newHashTree(t), newHashTree(t)
+	for _, h := range []HashTree{old1, old2, new} {
+		require.NoError(t, h.PutFile("/stable", obj(`hash:"20c2"`), 1))
+		require.NoError(t, h.PutFile("/dir/stable", obj(`hash:"20c2"`), 1))
+	}
+	require.NoError(t, old1.PutFile("/dir/old1-only", obj(`hash:"ebc5"`), 1))
+	require.NoError(t, old2.PutFile("/changed", obj(`hash:"ebc5"`), 1))
+	require.NoError(t, new.PutFile("/changed", obj(`hash:"9d43"`), 1))
+	require.NoError(t, new.PutFile("/dir/added", obj(`hash:"9d43"`), 1))
+	for _, h := range []HashTree{old1, old2, new} {
+		require.NoError(t, h.Hash())
+	}
+
+	type diffEntry struct {
+		path string
+		new  bool
+	}
+	// DiffMany against both old trees at once should report, per old tree,
+	// exactly what a pairwise Diff reports
+	got := map[int]map[diffEntry]bool{0: {}, 1: {}}
+	require.NoError(t, new.DiffMany([]HashTree{old1, old2}, "/", "/", -1,
+		func(oldIndex int, path string, node *NodeProto, isNew bool) error {
+			got[oldIndex][diffEntry{path, isNew}] = true
+			return nil
+		}))
+	for i, old := range []HashTree{old1, old2} {
+		want := map[diffEntry]bool{}
+		require.NoError(t, new.Diff(old, "/", "/", -1,
+			func(path string, node *NodeProto, isNew bool) error {
+				want[diffEntry{path, isNew}] = true
+				return nil
+			}))
+		require.Equal(t, want, got[i], "diff against old tree %d", i)
+	}
+
+	// Diffing against the same tree twice (including the tree itself) is fine
+	count := 0
+	require.NoError(t, new.DiffMany([]HashTree{old2, old2, new}, "/", "/", -1,
+		func(oldIndex int, path string, node *NodeProto, isNew bool) error {
+			if oldIndex == 2 {
+				return fmt.Errorf("tree should not differ from itself")
+			}
+			count++
+			return nil
+		}))
+	require.NotEqual(t, 0, count)
+}
+
+func TestLazyHashTree(t *testing.T) {
+	u := NewUnordered("")
+	u.PutFile("/foo", []byte("f0"), 1, blocks(``)...)
+	u.PutFile("/dir/bar", []byte("f1"), 1, blocks(``)...)
+	u.PutFile("/dir/baz", []byte("f2"), 1, blocks(``)...)
+	u.PutFile("/dir/sub/deep", []byte("f3"), 1, blocks(``)...)
+	streamBuf := &bytes.Buffer{}
+	require.NoError(t, u.Ordered().Serialize(streamBuf))
+	// Re-write the stream through a Writer to get its index, as the object
+	// storage path does
+	serialized := &bytes.Buffer{}
+	w := NewWriter(serialized)
+	require.NoError(t, w.Copy(NewReader(bytes.NewReader(streamBuf.Bytes()), nil)))
+	idx, err := w.Index()
+	require.NoError(t, err)
+	h := NewLazyHashTree(NewSerializedNodeSource(
+		bytes.NewReader(serialized.Bytes()), int64(serialized.Len()), idx))
+
+	// Point lookups
+	node, err := h.Get("/dir/bar")
+	require.NoError(t, err)
+	require.Equal(t, []byte("f1"), node.Hash)
+	node, err = h.Get("/dir")
+	require.NoError(t, err)
+	require.NotNil(t, node.DirNode)
+	_, err = h.Get("/nope")
+	require.YesError(t, err)
+	require.Equal(t, PathNotFound, Code(err))
+
+	// List is one level deep
+	names := []string{}
+	require.NoError(t, h.List("/dir", func(node *NodeProto) error {
+		names = append(names, node.Name)
+		return nil
+	}))
+	require.ElementsEqual(t, []string{"bar", "baz", "sub"}, names)
+
+	// Glob only fetches under the pattern's literal prefix
+	matches := []string{}
+	require.NoError(t, h.Glob("/dir/*", func(path string, node *NodeProto) error {
+		matches = append(matches, path)
+		return nil
+	}))
+	require.ElementsEqual(t, []string{"/dir/bar", "/dir/baz", "/dir/sub"}, matches)
+
+	// Walk visits the whole subtree in sorted order
+	walked := []string{}
+	require.NoError(t, h.Walk("/dir", func(path string, node *NodeProto) error {
+		walked = append(walked, path)
+		return nil
+	}))
+	require.Equal(t, []string{"/dir", "/dir/bar", "/dir/baz", "/dir/sub", "/dir/sub/deep"}, walked)
+}
+
+func TestSpillingUnordered(t *testing.T) {
+	// A tiny budget forces a spill every few files
+	var spills []*bytes.Buffer
+	su := NewSpillingUnordered("", 256, func(r io.Reader) error {
+		buf := &bytes.Buffer{}
+		if _, err := io.Copy(buf, r); err != nil {
+			return err
+		}
+		spills = append(spills, buf)
+		return nil
+	})
+	numFiles := 100
+	for i := 0; i < numFiles; i++ {
+		require.NoError(t, su.PutFile(fmt.Sprintf("/dir-%02d/foo-%02d", i/10, i),
+			[]byte{byte(i)}, 1, blocks(``)...))
+	}
+	n, err := su.Finish()
+	require.NoError(t, err)
+	require.Equal(t, len(spills), n)
+	require.True(t, n > 1, "expected the budget to force multiple spills, got %d", n)
+
+	// Merging the spilled trees yields every file that was put, and a root
+	// whose size covers them all
+	rs := make([]*Reader, len(spills))
+	for i, buf := range spills {
+		rs[i] = NewReader(bytes.NewReader(buf.Bytes()), nil)
+	}
+	mergedBuf := &bytes.Buffer{}
+	require.NoError(t, Merge(NewWriter(mergedBuf), rs))
+	files := make(map[string][]byte)
+	var rootSize int64
+	nr := NewReader(bytes.NewReader(mergedBuf.Bytes()), nil)
+	for {
+		mn, err := nr.Read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		nodeProto := &NodeProto{}
+		require.NoError(t, nodeProto.Unmarshal(mn.v))
+		if s(mn.k) == "" {
+			rootSize = nodeProto.SubtreeSize
+		}
+		if nodeProto.FileNode != nil {
+			files[s(mn.k)] = nodeProto.Hash
+		}
+	}
+	require.Equal(t, numFiles, len(files))
+	for i := 0; i < numFiles; i++ {
+		require.Equal(t, []byte{byte(i)}, files[fmt.Sprintf("/dir-%02d/foo-%02d", i/10, i)])
+	}
+	require.Equal(t, int64(numFiles), rootSize)
+
+	// A tree that never crosses the budget still spills once on Finish
+	spills = nil
+	su = NewSpillingUnordered("", 1<<20, func(r io.Reader) error {
+		buf := &bytes.Buffer{}
+		if _, err := io.Copy(buf, r); err != nil {
+			return err
+		}
+		spills = append(spills, buf)
+		return nil
+	})
+	require.NoError(t, su.PutFile("/foo", []byte("f0"), 1, blocks(``)...))
+	n, err = su.Finish()
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, 1, len(spills))
+}
+
+func TestValidatePathStrict(t *testing.T) {
+	for _, good := range []string{
+		"",
+		"/",
+		"/foo",
+		"/foo/bar-baz_1.txt",
+	} {
+		require.NoError(t, ValidatePathStrict(good), "path %q should be valid", good)
+	}
+	for _, bad := range []string{
+		"foo",         // no leading slash
+		"/foo\x00bar", // embedded NUL
+		"/foo/../bar", // '..' escape
+		"/foo/./bar",  // '.' component
+		"/foo//bar",   // empty component
+		"/foo/",       // trailing slash
+		"/foo*",       // glob character
+		"/foo\u00e9",  // non-ASCII
+	} {
+		err := ValidatePathStrict(bad)
+		require.YesError(t, err, "path %q should be invalid", bad)
+		require.Equal(t, MalformedPath, Code(err), "path %q", bad)
+	}
+
+	// The options relax exactly one check each
+	require.YesError(t, ValidatePathStrict("/foo/"))
+	require.NoError(t, ValidatePathStrict("/foo/", AllowTrailingSlash()))
+	require.YesError(t, ValidatePathStrict("/foo/", AllowGlobs()))
+	require.YesError(t, ValidatePathStrict("/foo/*"))
+	require.NoError(t, ValidatePathStrict("/foo/*", AllowGlobs()))
+
+	// ValidatePath still accepts what it used to: it cleans first
+	require.NoError(t, ValidatePath("/foo/../bar"))
+}
+
+func TestSharedAncestor(t *testing.T) {
+	h := newHashTree(t)
+	header := obj(`hash:"1d4a"`)[0]
+	require.NoError(t, h.PutDirHeaderFooter("/splits", header, nil, 10, 0))
+
+	// Children can be put under intermediate directories that don't carry the
+	// header themselves; the nearest ancestor with Shared data provides it
+	require.NoError(t, h.PutFileHeaderFooter("/splits/part-1/0000", obj(`hash:"20c2"`), 1))
+	require.NoError(t, h.PutFileHeaderFooter("/splits/part-2/0000", obj(`hash:"9d43"`), 1))
+	path, shared, err := h.SharedAncestor("/splits/part-1/0000")
+	require.NoError(t, err)
+	require.Equal(t, "/splits", path)
+	require.Equal(t, header.Hash, shared.Header.Hash)
+
+	// Direct children still resolve to their parent
+	require.NoError(t, h.PutFileHeaderFooter("/splits/0000", obj(`hash:"ebc5"`), 1))
+	path, _, err = h.SharedAncestor("/splits/0000")
+	require.NoError(t, err)
+	require.Equal(t, "/splits", path)
+
+	// Without any ancestor carrying headers, the put is rejected
+	err = h.PutFileHeaderFooter("/nosplit/0000", obj(`hash:"20c2"`), 1)
+	require.YesError(t, err)
+	require.Equal(t, HeaderFooterConflict, Code(err))
+	_, _, err = h.SharedAncestor("/nosplit/0000")
+	require.YesError(t, err)
+	require.Equal(t, HeaderFooterConflict, Code(err))
+}
+
+func TestMergeDedupe(t *testing.T) {
+	// Overlapping shard trees: "/both" carries identical object references in
+	// both trees, the other files are distinct
+	l, r := NewUnordered(""), NewUnordered("")
+	l.PutFile("/both", []byte("b0"), 1, blocks(``)...)
+	l.PutFile("/only-l", []byte("l0"), 1, blocks(``)...)
+	r.PutFile("/both", []byte("b0"), 1, blocks(``)...)
+	r.PutFile("/only-r", []byte("r0"), 1, blocks(``)...)
+	lBuf, rBuf := &bytes.Buffer{}, &bytes.Buffer{}
+	require.NoError(t, l.Ordered().Serialize(lBuf))
+	require.NoError(t, r.Ordered().Serialize(rBuf))
+	mergedBuf := &bytes.Buffer{}
+	require.NoError(t, Merge(NewWriter(mergedBuf), []*Reader{
+		NewReader(bytes.NewReader(lBuf.Bytes()), nil),
+		NewReader(bytes.NewReader(rBuf.Bytes()), nil),
+	}))
+	nodes := make(map[string]*NodeProto)
+	nr := NewReader(bytes.NewReader(mergedBuf.Bytes()), nil)
+	for {
+		n, err := nr.Read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		nodeProto := &NodeProto{}
+		require.NoError(t, nodeProto.Unmarshal(n.v))
+		nodes[s(n.k)] = nodeProto
+	}
+	// The identical copies collapsed: one set of block refs, size counted
+	// once, and the content hash untouched (no self-concatenation)
+	require.Equal(t, 1, len(nodes["/both"].FileNode.BlockRefs))
+	require.Equal(t, int64(1), nodes["/both"].SubtreeSize)
+	require.Equal(t, []byte("b0"), nodes["/both"].Hash)
+	// The distinct files are all there
+	require.Equal(t, []byte("l0"), nodes["/only-l"].Hash)
+	require.Equal(t, []byte("r0"), nodes["/only-r"].Hash)
+
+	// Two fully identical trees merge to exactly one of them
+	identicalBuf := &bytes.Buffer{}
+	require.NoError(t, Merge(NewWriter(identicalBuf), []*Reader{
+		NewReader(bytes.NewReader(lBuf.Bytes()), nil),
+		NewReader(bytes.NewReader(lBuf.Bytes()), nil),
+	}))
+	require.Equal(t, lBuf.Bytes(), identicalBuf.Bytes())
+}
+
+func TestRecomputeSizes(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/dir/foo", obj(`hash:"20c27"`), 5))
+	require.NoError(t, h.PutFile("/dir/bar", obj(`hash:"ebc57"`), 3))
+	require.NoError(t, h.PutFile("/baz", obj(`hash:"9d432"`), 2))
+	require.NoError(t, h.Hash())
+
+	// A healthy tree has nothing to repair
+	discrepancies, err := h.RecomputeSizes()
+	require.NoError(t, err)
+	require.Nil(t, discrepancies)
+
+	// Drift the root and /dir sizes directly in bolt
+	db := h.(*dbHashTree)
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		for path, delta := range map[string]int64{"": 100, "/dir": -4} {
+			node, err := get(tx, path)
+			if err != nil {
+				return err
+			}
+			node.SubtreeSize += delta
+			data, err := node.Marshal()
+			if err != nil {
+				return err
+			}
+			if err := fs(tx).Put(b(path), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	discrepancies, err = h.RecomputeSizes()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(discrepancies))
+	require.Equal(t, "", discrepancies[0].Path)
+	require.Equal(t, int64(110), discrepancies[0].Stored)
+	require.Equal(t, int64(10), discrepancies[0].Computed)
+	require.Equal(t, "/dir", discrepancies[1].Path)
+	require.Equal(t, int64(4), discrepancies[1].Stored)
+	require.Equal(t, int64(8), discrepancies[1].Computed)
+	require.True(t, len(discrepancies[0].String()) > 0)
+
+	// The tree was repaired in place
+	require.Equal(t, int64(10), h.FSSize())
+	require.Equal(t, int64(8), getT(t, h, "/dir").SubtreeSize)
+	discrepancies, err = h.RecomputeSizes()
+	require.NoError(t, err)
+	require.Nil(t, discrepancies)
+}
+
+func TestTreePool(t *testing.T) {
+	p, err := NewTreePool(100)
+	require.NoError(t, err)
+	p.Add("tree-1", newHashTree(t), 40)
+	p.Add("tree-2", newHashTree(t), 40)
+	require.Equal(t, int64(80), p.Used())
+	_, ok := p.Get("tree-1")
+	require.True(t, ok)
+	_, ok = p.Get("tree-2")
+	require.True(t, ok)
+
+	// Touch tree-1 so tree-2 is the eviction candidate, then blow the budget
+	p.Get("tree-1")
+	p.Add("tree-3", newHashTree(t), 40)
+	require.True(t, p.Used() <= 100, "pool over budget: %d", p.Used())
+	_, ok = p.Get("tree-2")
+	require.False(t, ok, "tree-2 should have been evicted")
+	_, ok = p.Get("tree-1")
+	require.True(t, ok)
+	_, ok = p.Get("tree-3")
+	require.True(t, ok)
+
+	// Re-adding under the same hash replaces the old entry instead of
+	// double-counting it
+	p.Add("tree-1", newHashTree(t), 50)
+	require.Equal(t, int64(90), p.Used())
+}
+
+func TestDeleteFiles(t *testing.T) {
+	newTree := func() HashTree {
+		h := newHashTree(t)
+		require.NoError(t, h.PutFile("/foo", obj(`hash:"20c27"`), 1))
+		require.NoError(t, h.PutFile("/dir/bar", obj(`hash:"ebc57"`), 1))
+		require.NoError(t, h.PutFile("/dir/baz", obj(`hash:"9d432"`), 1))
+		require.NoError(t, h.Hash())
+		return h
+	}
+
+	// Glob characters are rejected without DeleteAllowGlob
+	h := newTree()
+	_, err := h.DeleteFiles("/dir/*")
+	require.YesError(t, err)
+	require.Equal(t, MalformedPath, Code(err))
+	matched, err := h.DeleteFiles("/dir/*", DeleteAllowGlob())
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{"/dir/bar", "/dir/baz"}, matched)
+	nodes, err := h.ListAll("/dir")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(nodes))
+
+	// The root (and any non-empty directory) requires DeleteRecursive
+	h = newTree()
+	_, err = h.DeleteFiles("/")
+	require.YesError(t, err)
+	require.Equal(t, PathConflict, Code(err))
+	_, err = h.DeleteFiles("/dir")
+	require.YesError(t, err)
+	require.Equal(t, PathConflict, Code(err))
+	matched, err = h.DeleteFiles("/dir", DeleteRecursive())
+	require.NoError(t, err)
+	require.Equal(t, []string{"/dir"}, matched)
+	_, err = h.Get("/dir")
+	require.YesError(t, err)
+
+	// Dry run reports the matches and deletes nothing
+	h = newTree()
+	matched, err = h.DeleteFiles("/", DeleteAllowGlob(), DeleteRecursive(), DeleteDryRun())
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{"/foo", "/dir"}, matched)
+	_, err = h.Get("/foo")
+	require.NoError(t, err)
+	_, err = h.Get("/dir/bar")
+	require.NoError(t, err)
+
+	// Deleting a plain file needs no options, and a missing path is a no-op
+	h = newTree()
+	matched, err = h.DeleteFiles("/foo")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/foo"}, matched)
+	matched, err = h.DeleteFiles("/none")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(matched))
+}
+
+func TestListOptions(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/dir/small", obj(`hash:"20c27"`), 1))
+	require.NoError(t, h.PutFile("/dir/big", obj(`hash:"ebc57"`), 100))
+	require.NoError(t, h.PutFile("/dir/sub/leaf", obj(`hash:"9d432"`), 1))
+	require.NoError(t, h.Hash())
+
+	names := func(nodes []*NodeProto) []string {
+		var result []string
+		for _, n := range nodes {
+			result = append(result, n.Name)
+		}
+		return result
+	}
+
+	nodes, err := h.ListAll("/dir", ListFilesOnly())
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{"small", "big"}, names(nodes))
+
+	nodes, err = h.ListAll("/dir", ListDirsOnly())
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{"sub"}, names(nodes))
+
+	nodes, err = h.ListAll("/dir", ListMinSize(50))
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{"big"}, names(nodes))
+
+	nodes, err = h.ListAll("/dir", ListNameGlob("s*"))
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{"small", "sub"}, names(nodes))
+
+	_, err = h.ListAll("/dir", ListNameGlob("["))
+	require.YesError(t, err)
+	require.Equal(t, MalformedGlob, Code(err))
+
+	// Projection strips the object references but keeps names and sizes
+	nodes, err = h.ListAll("/dir", ListFilesOnly(), ListOmitObjects())
+	require.NoError(t, err)
+	for _, n := range nodes {
+		require.Equal(t, 0, len(n.FileNode.Objects))
+		require.True(t, n.SubtreeSize > 0)
+	}
+	// ...without touching the stored nodes
+	full, err := h.ListAll("/dir", ListFilesOnly())
+	require.NoError(t, err)
+	for _, n := range full {
+		require.Equal(t, 1, len(n.FileNode.Objects))
+	}
+}
+
+func TestIterator(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/dir/foo", obj(`hash:"20c27"`), 1))
+	require.NoError(t, h.PutFile("/dir/sub/bar", obj(`hash:"ebc57"`), 1))
+	require.NoError(t, h.PutFile("/other", obj(`hash:"8e02c"`), 1))
+	require.NoError(t, h.Hash())
+
+	// Iterating a subtree yields the directory and everything under it, in
+	// sorted-path order
+	it, err := h.NewIterator("/dir")
+	require.NoError(t, err)
+	var paths []string
+	for {
+		path, node, err := it.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		require.NotNil(t, node)
+		paths = append(paths, path)
+	}
+	require.Equal(t, []string{"/dir", "/dir/foo", "/dir/sub", "/dir/sub/bar"}, paths)
+	require.NoError(t, it.Close())
+
+	// Next after Close (and repeated Close) are safe
+	_, _, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.NoError(t, it.Close())
+
+	// Iterating the root yields every node
+	it, err = h.NewIterator("/")
+	require.NoError(t, err)
+	defer it.Close()
+	count := 0
+	for {
+		if _, _, err := it.Next(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		count++
+	}
+	require.Equal(t, lenT(t, h), count)
+}
+
+func TestFormatNegotiation(t *testing.T) {
+	// A format this build doesn't know yields an upgrade-required error with
+	// the writer's format
+	_, err := newNodeHasher(MaxSupportedFormat()+1, HashAlgorithmSHA256)
+	require.YesError(t, err)
+	require.Equal(t, Unsupported, Code(err))
+	require.Matches(t, "upgrade", err.Error())
+
+	// A tree serialized with a newer format can still be deserialized and
+	// read, but not rehashed
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/foo", obj(`hash:"20c27"`), 1))
+	require.NoError(t, h.Hash())
+	h.(*dbHashTree).hasher = &nodeHasher{format: MaxSupportedFormat() + 1, algorithm: HashAlgorithmSHA256}
+	var buf bytes.Buffer
+	require.NoError(t, h.Serialize(&buf))
+
+	h2, err := DeserializeDBHashTree("", &buf)
+	require.NoError(t, err)
+	_, err = h2.Get("/foo")
+	require.NoError(t, err)
+	err = h2.Hash()
+	require.YesError(t, err)
+	require.Equal(t, Unsupported, Code(err))
+}
+
+func TestEqual(t *testing.T) {
+	h1, h2 := newHashTree(t), newHashTree(t)
+	for _, h := range []HashTree{h1, h2} {
+		require.NoError(t, h.PutFile("/foo", obj(`hash:"20c27"`), 1))
+		require.NoError(t, h.PutFile("/dir/bar", obj(`hash:"ebc57"`), 1))
+		require.NoError(t, h.Hash())
+	}
+
+	// Identical trees compare equal (both via the root-hash short circuit and
+	// structurally), and a tree equals itself
+	eq, err := h1.Equal(h2)
+	require.NoError(t, err)
+	require.True(t, eq)
+	eq, err = h1.Equal(h1)
+	require.NoError(t, err)
+	require.True(t, eq)
+
+	// Subtrees at different paths compare by content
+	require.NoError(t, h1.PutFile("/other/bar", obj(`hash:"ebc57"`), 1))
+	require.NoError(t, h1.Hash())
+	eq, err = h1.ContentEquals(h2, "/other", "/dir")
+	require.NoError(t, err)
+	require.True(t, eq)
+
+	// A content change anywhere makes the trees unequal
+	eq, err = h1.Equal(h2)
+	require.NoError(t, err)
+	require.False(t, eq)
+	eq, err = h1.ContentEquals(h2, "/dir", "/dir")
+	require.NoError(t, err)
+	require.True(t, eq)
+	require.NoError(t, h2.PutFile("/dir/bar", obj(`hash:"8e02c"`), 1))
+	require.NoError(t, h2.Hash())
+	eq, err = h1.ContentEquals(h2, "/dir", "/dir")
+	require.NoError(t, err)
+	require.False(t, eq)
+
+	// Missing paths: both missing is vacuously equal, one missing is not
+	eq, err = h1.ContentEquals(h2, "/none", "/none")
+	require.NoError(t, err)
+	require.True(t, eq)
+	eq, err = h1.ContentEquals(h2, "/foo", "/none")
+	require.NoError(t, err)
+	require.False(t, eq)
+}
+
+func TestCheckpoint(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/foo", obj(`hash:"20c27"`), 1))
+	require.NoError(t, h.Hash())
+
+	checkpoint, err := h.Checkpoint()
+	require.NoError(t, err)
+
+	// Writes after the checkpoint are discarded by Revert
+	require.NoError(t, h.PutFile("/bar", obj(`hash:"ebc57"`), 1))
+	require.NoError(t, h.DeleteFile("/foo"))
+	require.NoError(t, h.Revert(checkpoint))
+	getT(t, h, "/foo")
+	_, err = h.Get("/bar")
+	require.YesError(t, err)
+	require.Equal(t, PathNotFound, Code(err))
+
+	// The checkpoint survives a revert, so it can be reverted to again, and
+	// the tree is writable afterwards
+	require.NoError(t, h.PutFile("/baz", obj(`hash:"8e02c"`), 1))
+	require.NoError(t, h.Revert(checkpoint))
+	require.Equal(t, 2, lenT(t, h))
+	require.NoError(t, h.PutFile("/qux", obj(`hash:"8e02c"`), 1))
+	getT(t, h, "/qux")
+
+	require.NoError(t, h.DropCheckpoint(checkpoint))
+	require.YesError(t, h.Revert(checkpoint))
+}
+
+func TestPutHardLink(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/orig", obj(`hash:"20c27"`), 1))
+	require.NoError(t, h.PutHardLink("/dir/link", "/orig"))
+	require.NoError(t, h.Hash())
+
+	// Both paths report the same content
+	orig, link := getT(t, h, "/orig"), getT(t, h, "/dir/link")
+	require.Equal(t, orig.FileNode.Objects, link.FileNode.Objects)
+	require.Equal(t, orig.SubtreeSize, link.SubtreeSize)
+
+	// An append through either path updates both
+	require.NoError(t, h.PutFile("/dir/link", obj(`hash:"ebc57"`), 1))
+	require.NoError(t, h.Hash())
+	orig, link = getT(t, h, "/orig"), getT(t, h, "/dir/link")
+	require.Equal(t, 2, len(orig.FileNode.Objects))
+	require.Equal(t, orig.FileNode.Objects, link.FileNode.Objects)
+	require.Equal(t, int64(2), getT(t, h, "/dir").SubtreeSize)
+	require.Equal(t, int64(4), getT(t, h, "").SubtreeSize)
+
+	// Deleting one path severs its link; the other keeps its contents and
+	// stops propagating
+	require.NoError(t, h.DeleteFile("/dir/link"))
+	require.NoError(t, h.PutFile("/orig", obj(`hash:"8e02c"`), 1))
+	require.NoError(t, h.Hash())
+	require.Equal(t, 3, len(getT(t, h, "/orig").FileNode.Objects))
+	_, err := h.Get("/dir/link")
+	require.YesError(t, err)
+
+	// Hard links only target regular files
+	require.NoError(t, h.PutDir("/adir"))
+	require.YesError(t, h.PutHardLink("/link2", "/adir"))
+
+	// Trees without hard links serialize exactly as before; trees with them
+	// round-trip their link groups
+	require.NoError(t, h.PutHardLink("/link3", "/orig"))
+	require.NoError(t, h.Hash())
+	var buf bytes.Buffer
+	require.NoError(t, h.Serialize(&buf))
+	h2, err := DeserializeDBHashTree("", &buf)
+	require.NoError(t, err)
+	require.NoError(t, h2.PutFile("/link3", obj(`hash:"9d432"`), 1))
+	require.NoError(t, h2.Hash())
+	require.Equal(t, 4, len(getT(t, h2, "/orig").FileNode.Objects))
+}
+
+func TestGlobGrouped(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/a/1", obj(`hash:"20c27"`), 1))
+	require.NoError(t, h.PutFile("/a/2", obj(`hash:"ebc57"`), 2))
+	require.NoError(t, h.PutFile("/b/sub/3", obj(`hash:"8e02c"`), 4))
+	require.NoError(t, h.PutFile("/top", obj(`hash:"9d432"`), 8))
+	require.NoError(t, h.Hash())
+
+	stats, err := h.GlobGrouped("/*/**")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(stats))
+	require.Equal(t, int64(2), stats["/a"].Matches)
+	require.Equal(t, int64(3), stats["/a"].SizeBytes)
+	require.Equal(t, int64(2), stats["/b"].Matches) // /b/sub and /b/sub/3
+	require.Equal(t, int64(8), stats["/b"].SizeBytes)
+
+	// A file at the root groups under its own component; the root groups
+	// under "/"
+	stats, err = h.GlobGrouped("/top")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats["/top"].Matches)
+	stats, err = h.GlobGrouped("/")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats["/"].Matches)
+
+	_, err = h.GlobGrouped("[")
+	require.YesError(t, err)
+}
+
+func TestShard(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/a/1", obj(`hash:"20c27"`), 3))
+	require.NoError(t, h.PutFile("/a/2", obj(`hash:"ebc57"`), 3))
+	require.NoError(t, h.PutFile("/b/1", obj(`hash:"8e02c"`), 3))
+	require.NoError(t, h.PutFile("/b/2", obj(`hash:"9d432"`), 3))
+	require.NoError(t, h.PutFile("/big", obj(`hash:"9d433"`), 20))
+	require.NoError(t, h.Hash())
+
+	shards, err := h.Shard(6)
+	require.NoError(t, err)
+	defer func() {
+		for _, shard := range shards {
+			require.NoError(t, shard.Destroy())
+		}
+	}()
+	// /a and /b each fit in a shard of their own; /big exceeds the target but
+	// is indivisible, so it gets its own shard too
+	require.Equal(t, 3, len(shards))
+
+	// Every file appears in exactly one shard, with its content intact, and
+	// each shard is internally consistent
+	files := make(map[string]int)
+	var total int64
+	for _, shard := range shards {
+		problems, err := shard.Validate()
+		require.NoError(t, err)
+		require.Equal(t, 0, len(problems))
+		require.NoError(t, shard.Walk("/", func(path string, node *NodeProto) error {
+			if node.nodetype() == file {
+				files[path]++
+			}
+			return nil
+		}))
+		total += shard.FSSize()
+	}
+	require.Equal(t, 5, len(files))
+	for path, count := range files {
+		require.Equal(t, 1, count, "file %s appears in %d shards", path, count)
+	}
+	require.Equal(t, h.FSSize(), total)
+
+	// A target that fits the whole tree yields a single shard equal to the
+	// original
+	shards2, err := h.Shard(1 << 30)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shards2))
+	eq, err := shards2[0].Equal(h)
+	require.NoError(t, err)
+	require.True(t, eq)
+	require.NoError(t, shards2[0].Destroy())
+
+	_, err = h.Shard(0)
+	require.YesError(t, err)
+}
+
+func TestSerializeCompressed(t *testing.T) {
+	h := newHashTree(t)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, h.PutFile(fmt.Sprintf("/dir/file%d", i), obj(`hash:"20c27"`), 1))
+	}
+	require.NoError(t, h.Hash())
+
+	var plain, compressed bytes.Buffer
+	require.NoError(t, h.Serialize(&plain))
+	require.NoError(t, h.Serialize(&compressed, WithCompression()))
+	require.True(t, compressed.Len() < plain.Len())
+
+	// Deserialize detects both formats without being told which it was given
+	h2, err := DeserializeDBHashTree("", &compressed)
+	require.NoError(t, err)
+	requireSame(t, h, h2)
+	h3, err := DeserializeDBHashTree("", &plain)
+	require.NoError(t, err)
+	requireSame(t, h, h3)
+}
+
+// TestDeterministicFingerprint guarantees the property caching layers keyed
+// on tree hash depend on: trees with identical logical contents have
+// byte-identical serialized forms and root hashes, regardless of the order
+// the files were put or how merges were grouped.
+func TestDeterministicFingerprint(t *testing.T) {
+	paths := []string{"/a/1", "/a/2", "/b/sub/1", "/c", "/d/x/y/z"}
+	forward, reverse := newHashTree(t), newHashTree(t)
+	for i, path := range paths {
+		require.NoError(t, forward.PutFile(path, obj(`hash:"20c27"`), 1))
+		require.NoError(t, reverse.PutFile(paths[len(paths)-1-i], obj(`hash:"20c27"`), 1))
+	}
+	require.NoError(t, forward.Hash())
+	require.NoError(t, reverse.Hash())
+
+	rootF, rootR := getT(t, forward, ""), getT(t, reverse, "")
+	require.True(t, bytes.Equal(rootF.Hash, rootR.Hash))
+	var bufF, bufR bytes.Buffer
+	require.NoError(t, forward.Serialize(&bufF))
+	require.NoError(t, reverse.Serialize(&bufR))
+	require.True(t, bytes.Equal(bufF.Bytes(), bufR.Bytes()))
+
+	// Merging the same trees with different grouping yields byte-identical
+	// streams: merging a, b, and c at once matches merging a and b first and
+	// then merging the result with c
+	trees := make([][]byte, 3)
+	for i := range trees {
+		u := NewUnordered("/")
+		u.PutFile("/shared", []byte(fmt.Sprintf("%16x", i)), 1, blocks(``)...)
+		u.PutFile(fmt.Sprintf("/only%d", i), []byte(fmt.Sprintf("%16x", 10+i)), 1, blocks(``)...)
+		var buf bytes.Buffer
+		require.NoError(t, u.Ordered().Serialize(&buf))
+		trees[i] = buf.Bytes()
+	}
+	readers := func(bs ...[]byte) []*Reader {
+		var rs []*Reader
+		for _, b := range bs {
+			rs = append(rs, NewReader(bytes.NewReader(b), nil))
+		}
+		return rs
+	}
+	var flat bytes.Buffer
+	require.NoError(t, Merge(NewWriter(&flat), readers(trees[0], trees[1], trees[2])))
+	var firstTwo bytes.Buffer
+	require.NoError(t, Merge(NewWriter(&firstTwo), readers(trees[0], trees[1])))
+	var nested bytes.Buffer
+	require.NoError(t, Merge(NewWriter(&nested), readers(firstTwo.Bytes(), trees[2])))
+	require.True(t, bytes.Equal(flat.Bytes(), nested.Bytes()))
+}
+
+func TestMergeConflictStrategies(t *testing.T) {
+	// 'l' has /conflict as a file, 'r' has it as a directory
+	l, r := NewUnordered(""), NewUnordered("")
+	l.PutFile("/conflict", []byte("l0"), 1, blocks(``)...)
+	l.PutFile("/zz", []byte("l1"), 1, blocks(``)...)
+	r.PutFile("/conflict/child", []byte("r0"), 1, blocks(``)...)
+	lBuf, rBuf := &bytes.Buffer{}, &bytes.Buffer{}
+	require.NoError(t, l.Ordered().Serialize(lBuf))
+	require.NoError(t, r.Ordered().Serialize(rBuf))
+	readers := func() []*Reader {
+		return []*Reader{
+			NewReader(bytes.NewReader(lBuf.Bytes()), nil),
+			NewReader(bytes.NewReader(rBuf.Bytes()), nil),
+		}
+	}
+	// mergedTypes runs the merged stream back through a reader and maps each
+	// path to its node type
+	mergedTypes := func(buf *bytes.Buffer) map[string]nodetype {
+		types := make(map[string]nodetype)
+		nr := NewReader(bytes.NewReader(buf.Bytes()), nil)
+		for {
+			n, err := nr.Read()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			nodeProto := &NodeProto{}
+			require.NoError(t, nodeProto.Unmarshal(n.v))
+			types[s(n.k)] = nodeProto.nodetype()
+		}
+		return types
+	}
+
+	// The default is still an error
+	err := Merge(NewWriter(&bytes.Buffer{}), readers())
+	require.YesError(t, err)
+	require.Equal(t, PathConflict, Code(err))
+
+	// Prefer-first keeps 'l's file and drops the directory's descendants
+	buf := &bytes.Buffer{}
+	require.NoError(t, Merge(NewWriter(buf), readers(),
+		WithConflictStrategy(ConflictPreferFirst)))
+	require.Equal(t, map[string]nodetype{
+		"":          directory,
+		"/conflict": file,
+		"/zz":       file,
+	}, mergedTypes(buf))
+
+	// With 'r' first, prefer-first keeps the directory and its child
+	buf = &bytes.Buffer{}
+	require.NoError(t, Merge(NewWriter(buf), []*Reader{
+		NewReader(bytes.NewReader(rBuf.Bytes()), nil),
+		NewReader(bytes.NewReader(lBuf.Bytes()), nil),
+	}, WithConflictStrategy(ConflictPreferFirst)))
+	require.Equal(t, map[string]nodetype{
+		"":                directory,
+		"/conflict":       directory,
+		"/conflict/child": file,
+		"/zz":             file,
+	}, mergedTypes(buf))
+
+	// Union-dirs keeps the directory variant regardless of reader order
+	buf = &bytes.Buffer{}
+	require.NoError(t, Merge(NewWriter(buf), readers(),
+		WithConflictStrategy(ConflictUnionDirs)))
+	require.Equal(t, map[string]nodetype{
+		"":                directory,
+		"/conflict":       directory,
+		"/conflict/child": file,
+		"/zz":             file,
+	}, mergedTypes(buf))
+
+	// A resolver callback sees all conflicting variants and picks the winner
+	buf = &bytes.Buffer{}
+	require.NoError(t, Merge(NewWriter(buf), readers(),
+		WithConflictResolver(func(path string, ns []*NodeProto) (*NodeProto, error) {
+			require.Equal(t, "/conflict", path)
+			require.Equal(t, 2, len(ns))
+			for _, n := range ns {
+				if n.nodetype() == file {
+					return n, nil
+				}
+			}
+			return nil, fmt.Errorf("no file variant at %s", path)
+		})))
+	require.Equal(t, map[string]nodetype{
+		"":          directory,
+		"/conflict": file,
+		"/zz":       file,
+	}, mergedTypes(buf))
+
+	// Resolver errors abort the merge
+	err = Merge(NewWriter(&bytes.Buffer{}), readers(),
+		WithConflictResolver(func(path string, ns []*NodeProto) (*NodeProto, error) {
+			return nil, fmt.Errorf("can't resolve %s", path)
+		}))
+	require.YesError(t, err)
+	require.Matches(t, "can't resolve /conflict", err.Error())
+
+	// MergeParallel applies the same options and produces the same stream
+	parallelBuf := &bytes.Buffer{}
+	require.NoError(t, MergeParallel(NewWriter(parallelBuf), readers(),
+		DefaultMergeConcurrency, WithConflictStrategy(ConflictUnionDirs)))
+	require.Equal(t, map[string]nodetype{
+		"":                directory,
+		"/conflict":       directory,
+		"/conflict/child": file,
+		"/zz":             file,
+	}, mergedTypes(parallelBuf))
+}
+
+func TestMerge3(t *testing.T) {
+	// 'base' is the common ancestor; 'ours' and 'theirs' each change it
+	base, ours, theirs := NewUnordered(""), NewUnordered(""), NewUnordered("")
+	base.PutFile("/same", []byte("s0"), 1, blocks(``)...)
+	base.PutFile("/ours-change", []byte("c0"), 1, blocks(``)...)
+	base.PutFile("/theirs-change", []byte("d0"), 1, blocks(``)...)
+	base.PutFile("/both-change", []byte("e0"), 1, blocks(``)...)
+	base.PutFile("/del-theirs", []byte("f0"), 1, blocks(``)...)
+	base.PutFile("/deldir/f", []byte("g0"), 1, blocks(``)...)
+	base.PutFile("/mod-del", []byte("h0"), 1, blocks(``)...)
+	ours.PutFile("/same", []byte("s0"), 1, blocks(``)...)
+	ours.PutFile("/ours-change", []byte("c1"), 1, blocks(``)...)
+	ours.PutFile("/theirs-change", []byte("d0"), 1, blocks(``)...)
+	ours.PutFile("/both-change", []byte("e1"), 1, blocks(``)...)
+	ours.PutFile("/del-theirs", []byte("f0"), 1, blocks(``)...)
+	ours.PutFile("/mod-del", []byte("h1"), 1, blocks(``)...)
+	ours.PutFile("/added-ours", []byte("i0"), 1, blocks(``)...)
+	theirs.PutFile("/same", []byte("s0"), 1, blocks(``)...)
+	theirs.PutFile("/ours-change", []byte("c0"), 1, blocks(``)...)
+	theirs.PutFile("/theirs-change", []byte("d1"), 1, blocks(``)...)
+	theirs.PutFile("/both-change", []byte("e2"), 1, blocks(``)...)
+	theirs.PutFile("/deldir/f", []byte("g0"), 1, blocks(``)...)
+	baseBuf, oursBuf, theirsBuf := &bytes.Buffer{}, &bytes.Buffer{}, &bytes.Buffer{}
+	require.NoError(t, base.Ordered().Serialize(baseBuf))
+	require.NoError(t, ours.Ordered().Serialize(oursBuf))
+	require.NoError(t, theirs.Ordered().Serialize(theirsBuf))
+	readers := func() (b, o, th *Reader) {
+		return NewReader(bytes.NewReader(baseBuf.Bytes()), nil),
+			NewReader(bytes.NewReader(oursBuf.Bytes()), nil),
+			NewReader(bytes.NewReader(theirsBuf.Bytes()), nil)
+	}
+	// mergedHashes maps each path in the merged stream to its node hash
+	mergedHashes := func(buf *bytes.Buffer) map[string][]byte {
+		hashes := make(map[string][]byte)
+		nr := NewReader(bytes.NewReader(buf.Bytes()), nil)
+		for {
+			n, err := nr.Read()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			nodeProto := &NodeProto{}
+			require.NoError(t, nodeProto.Unmarshal(n.v))
+			hashes[s(n.k)] = nodeProto.Hash
+		}
+		return hashes
+	}
+
+	// The both-changed path is a conflict by default
+	b, o, th := readers()
+	err := Merge3(NewWriter(&bytes.Buffer{}), b, o, th)
+	require.YesError(t, err)
+	require.Equal(t, PathConflict, Code(err))
+	require.Matches(t, "both-change", err.Error())
+
+	// With ours winning: non-conflicting changes from both sides land, the
+	// paths deleted on one side stay deleted (including /deldir's child), and
+	// the conflicts go ours' way
+	buf := &bytes.Buffer{}
+	b, o, th = readers()
+	require.NoError(t, Merge3(NewWriter(buf), b, o, th,
+		WithConflictStrategy(ConflictPreferFirst)))
+	hashes := mergedHashes(buf)
+	require.Equal(t, []byte("i0"), hashes["/added-ours"])
+	require.Equal(t, []byte("e1"), hashes["/both-change"])
+	require.Equal(t, []byte("h1"), hashes["/mod-del"])
+	require.Equal(t, []byte("c1"), hashes["/ours-change"])
+	require.Equal(t, []byte("s0"), hashes["/same"])
+	require.Equal(t, []byte("d1"), hashes["/theirs-change"])
+	for _, deleted := range []string{"/del-theirs", "/deldir", "/deldir/f"} {
+		_, ok := hashes[deleted]
+		require.False(t, ok, "path %s should have been deleted", deleted)
+	}
+
+	// A resolver can pick either variant, or delete the path by returning nil
+	buf = &bytes.Buffer{}
+	b, o, th = readers()
+	require.NoError(t, Merge3(NewWriter(buf), b, o, th,
+		WithConflictResolver(func(path string, ns []*NodeProto) (*NodeProto, error) {
+			switch path {
+			case "/both-change":
+				return ns[1], nil // theirs
+			case "/mod-del":
+				return nil, nil // side with the deletion wins
+			}
+			return nil, fmt.Errorf("unexpected conflict at %s", path)
+		})))
+	hashes = mergedHashes(buf)
+	require.Equal(t, []byte("e2"), hashes["/both-change"])
+	_, ok := hashes["/mod-del"]
+	require.False(t, ok, "resolver deleted /mod-del")
+}
+
+func TestMergeParallel(t *testing.T) {
+	l, r := NewUnordered(""), NewUnordered("")
+	for i := 0; i < 100; i++ {
+		l.PutFile(fmt.Sprintf("/dir-left/foo-%03d", i), []byte{byte(i)}, 1, blocks(``)...)
+		l.PutFile(fmt.Sprintf("/dir-shared/bar-%03d", i), []byte{byte(i)}, 1, blocks(``)...)
+		r.PutFile(fmt.Sprintf("/dir-right/foo-%03d", i), []byte{byte(i)}, 1, blocks(``)...)
+		r.PutFile(fmt.Sprintf("/dir-shared/bar-%03d", i), []byte{byte(i)}, 1, blocks(``)...)
+	}
+	lBuf, rBuf := &bytes.Buffer{}, &bytes.Buffer{}
+	require.NoError(t, l.Ordered().Serialize(lBuf))
+	require.NoError(t, r.Ordered().Serialize(rBuf))
+	// A parallel merge should produce exactly the same stream as a sequential
+	// merge
+	sequentialBuf := &bytes.Buffer{}
+	require.NoError(t, Merge(NewWriter(sequentialBuf),
+		[]*Reader{NewReader(bytes.NewReader(lBuf.Bytes()), nil), NewReader(bytes.NewReader(rBuf.Bytes()), nil)}))
+	parallelBuf := &bytes.Buffer{}
+	require.NoError(t, MergeParallel(NewWriter(parallelBuf),
+		[]*Reader{NewReader(bytes.NewReader(lBuf.Bytes()), nil), NewReader(bytes.NewReader(rBuf.Bytes()), nil)},
+		DefaultMergeConcurrency))
+	require.Equal(t, sequentialBuf, parallelBuf)
+}
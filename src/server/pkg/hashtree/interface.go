@@ -54,12 +54,23 @@ const (
 	// that doesn't exist.
 	ObjectNotFound
 
+	// TooManySymlinks is returned when resolving a path crosses more symlinks
+	// than the resolution limit allows (usually because the links form a
+	// cycle).
+	TooManySymlinks
+
 	// HeaderFooterConflict is returned when PutFileHeaderFooter is called on a
 	// path of the form parent/child, but the DirectoryNode at 'parent' doesn't
 	// have a header or footer (headers and footers cannot be added to directories
 	// retroactively, as that would require modifying all of the directory's
 	// children to indicate that they include header data in their parent)
 	HeaderFooterConflict
+
+	// MalformedPath is returned by strict path validation (see
+	// ValidatePathStrict) when a path is invalid: it contains a NUL byte, a
+	// '.' or '..' component, an empty component, or a trailing slash where
+	// one isn't allowed.
+	MalformedPath
 )
 
 // HashTree is the signature of a hash tree provided by this library. To get a
@@ -70,19 +81,39 @@ type HashTree interface {
 	// Get retrieves a file.
 	Get(path string) (*NodeProto, error)
 
-	// List calls f with the files and subdirectories of the directory at 'path'.
-	List(path string, f func(node *NodeProto) error) error
+	// List calls f with the files and subdirectories of the directory at
+	// 'path'. Options can filter by node type, name glob, or minimum size,
+	// and strip object references from the reported nodes.
+	List(path string, f func(node *NodeProto) error, opts ...ListOption) error
 
 	// ListAll is like List but aggregates its results into a slice.
-	ListAll(path string) ([]*NodeProto, error)
+	ListAll(path string, opts ...ListOption) ([]*NodeProto, error)
 
 	// Glob calls f with the file/directory paths and nodes that match 'pattern'.
 	Glob(pattern string, f func(path string, node *NodeProto) error) error
 
+	// GlobExclude is like Glob, but skips paths that also match one of the
+	// glob patterns in 'excludes'.
+	GlobExclude(pattern string, excludes []string, f func(path string, node *NodeProto) error) error
+
+	// GlobPaged is like Glob, but resumes after the path 'start' (if
+	// non-empty) and stops after 'limit' matches (if limit > 0), returning
+	// the last path emitted ("" once the matches are exhausted).
+	GlobPaged(pattern, start string, limit int64, f func(path string, node *NodeProto) error) (string, error)
+
+	// GlobGrouped runs 'pattern' and returns the matches grouped by their
+	// first path component, with a match count and total size per group.
+	GlobGrouped(pattern string) (map[string]*GlobStat, error)
+
 	// FSSize gets the size of the file system that this tree represents.
 	// It's essentially a helper around h.Get("/").SubtreeBytes
 	FSSize() int64
 
+	// NewIterator returns a pull-based iterator over the node at 'path' and
+	// everything under it, in sorted-path order. Its Next method returns
+	// io.EOF once the subtree is exhausted, and Close must always be called.
+	NewIterator(path string) (*Iterator, error)
+
 	// Walk calls a given function against every node in the hash tree.
 	// The order of traversal is not guaranteed.  If any invocation of the
 	// function returns an error, the walk stops and returns the error.
@@ -94,12 +125,44 @@ type HashTree interface {
 	// Specify '-1' for fully recursive, or '1' for shallow diff
 	Diff(oldHashTree HashTree, newPath string, oldPath string, recursiveDepth int64, f func(path string, node *NodeProto, new bool) error) error
 
-	// Serialize serializes a binary version of the HashTree to w.
-	Serialize(w io.Writer) error
+	// DiffRenames is like Diff, but reports a deleted file and an added file
+	// with identical content hashes as a single rename (the added path, with
+	// the deleted path in renamedFrom).
+	DiffRenames(oldHashTree HashTree, newPath string, oldPath string, recursiveDepth int64, f func(path string, node *NodeProto, new bool, renamedFrom string) error) error
+
+	// DiffStats returns a per-top-level-directory summary of the diff against
+	// oldHashTree (see Diff for the other parameters).
+	DiffStats(oldHashTree HashTree, newPath string, oldPath string, recursiveDepth int64) (map[string]*DiffStat, error)
+
+	// DiffMany is like Diff, but compares the tree against several old trees
+	// in a single traversal; f additionally receives the index of the old
+	// tree the reported path differs from.
+	DiffMany(oldHashTrees []HashTree, newPath string, oldPath string, recursiveDepth int64, f func(oldIndex int, path string, node *NodeProto, new bool) error) error
+
+	// Equal reports whether two trees have the same content, short-circuiting
+	// on the root hashes when possible.
+	Equal(other HashTree) (bool, error)
+
+	// ContentEquals reports whether the subtree at 'path' has the same content
+	// as the subtree at 'otherPath' in 'other', short-circuiting on subtree
+	// hashes when both trees hash nodes the same way.
+	ContentEquals(other HashTree, path, otherPath string) (bool, error)
+
+	// Serialize serializes a binary version of the HashTree to w, optionally
+	// compressed (see WithCompression). Deserialize detects either format.
+	Serialize(w io.Writer, opts ...SerializeOption) error
 
 	// Copy returns a copy of the HashTree
 	Copy() (HashTree, error)
 
+	// Subtree returns a self-contained HashTree rooted at the directory at
+	// 'path'.
+	Subtree(path string) (HashTree, error)
+
+	// Shard partitions the tree into self-contained subtrees of roughly
+	// 'targetBytes' each, split along directory boundaries.
+	Shard(targetBytes int64) ([]HashTree, error)
+
 	// Write methods
 
 	// PutDirHeaderFooter creates a directory at 'path' with the given header
@@ -126,6 +189,11 @@ type HashTree interface {
 	// still check the parent directory for header data in the future).
 	PutDirHeaderFooter(path string, header, footer *pfs.Object, headerSize, footerSize int64) error
 
+	// SharedAncestor returns the path and Shared data of the nearest ancestor
+	// directory of 'path' with headers/footers attached, which may be above
+	// the immediate parent for nested split formats.
+	SharedAncestor(path string) (string, *Shared, error)
+
 	// PutFile appends data to a file (and creates the file if it doesn't exist).
 	PutFile(path string, objects []*pfs.Object, size int64) error
 
@@ -146,16 +214,72 @@ type HashTree interface {
 	// the size of the objects removed.
 	PutFileOverwrite(path string, objects []*pfs.Object, overwriteIndex *pfs.OverwriteIndex, sizeDelta int64) error
 
+	// PutFiles applies many file insertions in a single pass, deferring and
+	// aggregating ancestor updates so each ancestor directory is written
+	// once per batch instead of once per file.
+	PutFiles(ops []PutFileOp) error
+
 	// PutDir creates a directory (or does nothing if one exists).
 	PutDir(path string) error
 
+	// PutSymlink creates a symbolic link at 'path' pointing to 'target'
+	// (which may be absolute, or relative to the link's directory). Get
+	// follows links; List, Walk, and Glob return the link nodes themselves.
+	PutSymlink(path string, target string) error
+
+	// PutHardLink creates a file at 'path' that shares the file node of the
+	// existing file at 'target', so writes through either path update both.
+	PutHardLink(path string, target string) error
+
+	// Stats computes the tree's statistics (node and object counts, max
+	// depth, and a file-size histogram) in a single walk over the nodes.
+	Stats() (*TreeStats, error)
+
+	// Validate checks the tree's structural invariants (nodes unmarshal,
+	// parents exist and are directories, directory sizes sum correctly, and
+	// stored hashes match recomputed ones) and returns one problem per
+	// violation, or nil if the tree is well-formed.
+	Validate() ([]*ValidationProblem, error)
+
+	// RecomputeSizes re-derives SubtreeSize bottom-up, repairs directories
+	// whose stored size drifted, and reports the discrepancies.
+	RecomputeSizes() ([]*SizeDiscrepancy, error)
+
+	// SetAttributes merges 'attributes' into the key/value metadata of the
+	// node at 'path'; setting a key to "" deletes it. Attributes survive
+	// Serialize and Merge, and a node that has any includes them in its hash.
+	SetAttributes(path string, attributes map[string]string) error
+
+	// CopyFile duplicates the file, symlink, or directory subtree at 'src'
+	// at 'dst' by reference (the copies share their object lists), so
+	// copying doesn't re-put every object reference. 'dst' must not already
+	// exist and must not be inside 'src'.
+	CopyFile(src, dst string) error
+
 	// DeleteFile deletes a regular file or directory (along with its children).
 	DeleteFile(path string) error
 
+	// DeleteFiles is DeleteFile with safeguards: globs require the
+	// DeleteAllowGlob option, non-empty directories (and the root) require
+	// DeleteRecursive, and DeleteDryRun reports the matched paths without
+	// deleting them. It returns the deleted (or matched) paths.
+	DeleteFiles(path string, opts ...DeleteOption) ([]string, error)
+
 	// Hash updates all of the hashes and node size metadata, it also checks
 	// for conflicts.
 	Hash() error
 
+	// Checkpoint snapshots the tree's current state and returns a token for
+	// it, so later writes can be rolled back with Revert.
+	Checkpoint() (string, error)
+
+	// Revert discards every write made since 'checkpoint' was taken. The
+	// checkpoint remains valid, so it can be reverted to more than once.
+	Revert(checkpoint string) error
+
+	// DropCheckpoint deletes a checkpoint, releasing its disk space.
+	DropCheckpoint(checkpoint string) error
+
 	// Deserialize deserializes a HashTree from r, into the receiver of the function.
 	Deserialize(r io.Reader) error
 
@@ -0,0 +1,207 @@
+package hashtree
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+)
+
+// NodeSource fetches hashtree nodes on demand, so a tree can be read without
+// deserializing it in full first (e.g. straight out of object storage).
+type NodeSource interface {
+	// Get returns the node at 'path' (which must be clean, see clean()), or
+	// a PathNotFound error if there is none.
+	Get(path string) (*NodeProto, error)
+
+	// Iterate calls f with the node at 'prefix' and every node under it, in
+	// sorted-path order. Returning errutil.ErrBreak from f stops the
+	// iteration without error.
+	Iterate(prefix string, f func(path string, node *NodeProto) error) error
+}
+
+// LazyHashTree is a read-only view of a hashtree whose nodes are fetched on
+// demand from a NodeSource. Get, List, Glob, and Walk only touch the paths
+// they need, which makes point reads of huge historical commits cheap: the
+// tree is never deserialized as a whole.
+type LazyHashTree struct {
+	src NodeSource
+}
+
+// NewLazyHashTree returns a read-only hashtree view backed by 'src'.
+func NewLazyHashTree(src NodeSource) *LazyHashTree {
+	return &LazyHashTree{src: src}
+}
+
+// Get gets a hashtree node. Like dbHashTree.Get, it follows symlinks (up to
+// maxSymlinkHops) and returns the node they ultimately target.
+func (h *LazyHashTree) Get(path string) (*NodeProto, error) {
+	trace("get", path)
+	path = clean(path)
+	node, err := h.src.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	for hops := 0; node.nodetype() == symlink; hops++ {
+		if hops >= maxSymlinkHops {
+			return nil, errorf(TooManySymlinks,
+				"too many levels of symbolic links resolving %q", path)
+		}
+		target := node.SymlinkNode.Target
+		if strings.HasPrefix(target, "/") {
+			path = clean(target)
+		} else {
+			parent, _ := split(path)
+			path = join(parent, target)
+		}
+		if node, err = h.src.Get(path); err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+// List executes a callback for each file under a directory (or a file if the
+// path is a file).
+func (h *LazyHashTree) List(path string, f func(*NodeProto) error) error {
+	trace("list", path)
+	path = clean(path)
+	node, err := h.src.Get(path)
+	if err != nil {
+		return err
+	}
+	if node.nodetype() != directory {
+		return f(node)
+	}
+	return h.src.Iterate(path, func(p string, node *NodeProto) error {
+		rel := strings.TrimPrefix(p, path+"/")
+		if p == path || strings.Contains(rel, "/") {
+			return nil // the directory itself, or deeper than one level
+		}
+		return f(node)
+	})
+}
+
+// ListAll retrieves all the files under a directory (or a file if the path is
+// a file).
+func (h *LazyHashTree) ListAll(path string) ([]*NodeProto, error) {
+	var result []*NodeProto
+	if err := h.List(path, func(node *NodeProto) error {
+		result = append(result, node)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Glob executes a callback for each path that matches the glob pattern. Only
+// the paths under the pattern's literal prefix are fetched from the source.
+func (h *LazyHashTree) Glob(pattern string, f func(string, *NodeProto) error) error {
+	trace("glob", pattern)
+	pattern = clean(pattern)
+	if !IsGlob(pattern) {
+		node, err := h.src.Get(pattern)
+		if err != nil {
+			return err
+		}
+		return f(externalDefault(pattern), node)
+	}
+	g, err := compileGlob(pattern)
+	if err != nil {
+		return err
+	}
+	return h.src.Iterate(clean(GlobLiteralPrefix(pattern)), func(path string, node *NodeProto) error {
+		if g.Match(path) {
+			return f(externalDefault(path), node)
+		}
+		return nil
+	})
+}
+
+// Walk calls a given function against the node at 'path' and every node under
+// it, in sorted-path order.
+func (h *LazyHashTree) Walk(path string, f func(path string, node *NodeProto) error) error {
+	path = clean(path)
+	if _, err := h.src.Get(path); err != nil {
+		return err
+	}
+	return h.src.Iterate(path, func(p string, node *NodeProto) error {
+		return f(externalDefault(p), node)
+	})
+}
+
+// serializedNodeSource is a NodeSource that reads nodes out of a serialized
+// hashtree with ranged reads, using the tree's index to seek near the
+// requested path.
+type serializedNodeSource struct {
+	r    io.ReaderAt
+	size int64
+	idx  []byte
+}
+
+// NewSerializedNodeSource returns a NodeSource that reads nodes straight out
+// of a serialized hashtree: 'r' reads the serialized tree (typically ranged
+// GETs against object storage), 'size' is the tree's total serialized length,
+// and 'idx' is its index (see Writer.Index). Lookups use the index to fetch
+// only the byte range that can contain the requested paths.
+func NewSerializedNodeSource(r io.ReaderAt, size int64, idx []byte) NodeSource {
+	return &serializedNodeSource{r: r, size: size, idx: idx}
+}
+
+func (src *serializedNodeSource) Get(path string) (*NodeProto, error) {
+	var result *NodeProto
+	if err := src.Iterate(path, func(p string, node *NodeProto) error {
+		if p == path {
+			result = node
+			return errutil.ErrBreak
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, errorf(PathNotFound, "file \"%s\" not found", path)
+	}
+	return result, nil
+}
+
+func (src *serializedNodeSource) Iterate(prefix string, f func(path string, node *NodeProto) error) error {
+	offset, length, err := GetRangeFromIndex(bytes.NewReader(src.idx), prefix)
+	if err != nil {
+		return err
+	}
+	if length == 0 {
+		length = uint64(src.size) - offset
+	}
+	// The range from the index is coarse (one entry per IndexSize bytes), so
+	// filter the keys down to the requested prefix
+	pre := b(prefix)
+	filter := func(k []byte) bool {
+		if prefix == "" {
+			return true // all paths are under the root
+		}
+		return bytes.Equal(k, pre) || bytes.HasPrefix(k, append(pre, nullByte...))
+	}
+	r := NewReader(io.NewSectionReader(src.r, int64(offset), int64(length)), filter)
+	for {
+		n, err := r.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		node := &NodeProto{}
+		if err := node.Unmarshal(n.v); err != nil {
+			return err
+		}
+		if err := f(s(n.k), node); err != nil {
+			if err == errutil.ErrBreak {
+				return nil
+			}
+			return err
+		}
+	}
+}
@@ -70,6 +70,69 @@ func join(ps ...string) string {
 	return clean(path.Join(ps...))
 }
 
+// ValidateOption configures ValidatePathStrict.
+type ValidateOption func(*validateOptions)
+
+type validateOptions struct {
+	allowTrailingSlash bool
+	allowGlobs         bool
+}
+
+// AllowTrailingSlash permits a single trailing slash, for callers validating
+// directory paths.
+func AllowTrailingSlash() ValidateOption {
+	return func(o *validateOptions) { o.allowTrailingSlash = true }
+}
+
+// AllowGlobs permits globbing characters, for callers validating patterns
+// rather than file paths.
+func AllowGlobs() ValidateOption {
+	return func(o *validateOptions) { o.allowGlobs = true }
+}
+
+// ValidatePathStrict checks a path without canonicalizing it first. Unlike
+// ValidatePath, which cleans the path and so silently accepts input like
+// "/foo/../bar" or "/foo//bar", strict validation rejects anything that only
+// becomes legal after normalization, returning a MalformedPath error. It's
+// meant for API boundaries (e.g. PFS request handlers) where a path that
+// needs rewriting is more likely a client bug than an intention.
+func ValidatePathStrict(p string, opts ...ValidateOption) error {
+	o := &validateOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if p == "" || p == "/" {
+		return nil // the root
+	}
+	if strings.ContainsRune(p, '\x00') {
+		return errorf(MalformedPath, "path (%v) invalid: embedded NUL byte", p)
+	}
+	if match, _ := regexp.MatchString("^[ -~]+$", p); !match {
+		return errorf(MalformedPath, "path (%v) invalid: only printable ASCII characters allowed", p)
+	}
+	if !o.allowGlobs && IsGlob(p) {
+		return errorf(MalformedPath, "path (%v) invalid: globbing character (%v) not allowed in path", p, globRegex.FindString(p))
+	}
+	if !strings.HasPrefix(p, "/") {
+		return errorf(MalformedPath, "path (%v) invalid: must begin with '/'", p)
+	}
+	if strings.HasSuffix(p, "/") {
+		if !o.allowTrailingSlash {
+			return errorf(MalformedPath, "path (%v) invalid: trailing slash not allowed", p)
+		}
+		p = strings.TrimSuffix(p, "/")
+	}
+	for _, c := range strings.Split(p[1:], "/") {
+		switch c {
+		case "":
+			return errorf(MalformedPath, "path (%v) invalid: empty path component", p)
+		case ".", "..":
+			return errorf(MalformedPath, "path (%v) invalid: path component %q not allowed", p, c)
+		}
+	}
+	return nil
+}
+
 // ValidatePath checks if a file path is legal
 func ValidatePath(path string) error {
 	path = clean(path)
@@ -0,0 +1,101 @@
+package hashtree
+
+import (
+	"archive/tar"
+	"io"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+)
+
+// ExportTar writes the contents of 'h' as a tar stream: one entry per
+// directory, symbolic link, and file, walked in sorted-path order. File
+// contents aren't stored in the tree itself, so they're fetched through
+// 'getContent', which must write the file's bytes (e.g. from the object
+// store) to 'w'. This gives a standard interchange path for getting whole
+// commits out of PFS.
+func ExportTar(h HashTree, w io.Writer, getContent func(path string, node *NodeProto, w io.Writer) error) (retErr error) {
+	tw := tar.NewWriter(w)
+	defer func() {
+		if err := tw.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	return h.Walk("/", func(path string, node *NodeProto) error {
+		if path == "/" {
+			return nil // tar files don't record the root directory
+		}
+		name := strings.TrimPrefix(clean(path), "/")
+		hdr := &tar.Header{
+			Name: name,
+			Mode: 0644,
+		}
+		switch node.nodetype() {
+		case directory:
+			hdr.Name += "/"
+			hdr.Typeflag = tar.TypeDir
+			hdr.Mode = 0755
+		case symlink:
+			hdr.Typeflag = tar.TypeSymlink
+			hdr.Linkname = node.SymlinkNode.Target
+		case file:
+			hdr.Typeflag = tar.TypeReg
+			hdr.Size = node.SubtreeSize
+		default:
+			return errorf(Internal, "node at %q has unrecognized type", path)
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if node.nodetype() == file {
+			return getContent(path, node, tw)
+		}
+		return nil
+	})
+}
+
+// ImportTar reads a tar stream and puts its entries into 'h'. File contents
+// have to live in object storage, not the tree, so each regular file is
+// handed to 'putContent', which must store the entry's bytes and return the
+// object references (and total size) to record in the tree. This is the
+// inverse of ExportTar and gives a standard interchange path for getting
+// whole commits into PFS.
+func ImportTar(h HashTree, r io.Reader, putContent func(path string, r io.Reader) ([]*pfs.Object, int64, error)) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		path := clean(hdr.Name)
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := h.PutDir(path); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := h.PutSymlink(path, hdr.Linkname); err != nil {
+				return err
+			}
+		case tar.TypeLink:
+			// tar hard-link targets are archive-root-relative
+			if err := h.PutHardLink(path, "/"+hdr.Linkname); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			objects, size, err := putContent(path, tr)
+			if err != nil {
+				return err
+			}
+			if err := h.PutFile(path, objects, size); err != nil {
+				return err
+			}
+		default:
+			// tar streams from other tools can carry entries with no PFS
+			// equivalent (char devices, pax headers, etc.); skip them
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package hashtree
+
+import (
+	"os"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Tracer receives one event per traced hashtree operation ('op' is the
+// operation name, e.g. "get" or "glob", and 'path' its path or pattern
+// argument). Tracing is off by default and disabled traces cost a single
+// branch, so big trees don't pay for per-operation logging unless someone
+// asks for it — either by installing a tracer with SetTracer or by setting
+// the HASHTREE_TRACE environment variable, which logs through logrus.
+type Tracer func(op string, path string)
+
+var (
+	// tracing gates all trace calls so the disabled path is one branch
+	tracing bool
+	tracer  Tracer
+)
+
+func init() {
+	if os.Getenv("HASHTREE_TRACE") != "" {
+		SetTracer(func(op, path string) {
+			logrus.Infof("hashtree: %s %q", op, path)
+		})
+	}
+}
+
+// SetTracer installs 'f' as the hashtree tracer; passing nil disables
+// tracing again. It must not be called concurrently with tree operations.
+func SetTracer(f Tracer) {
+	tracer = f
+	tracing = f != nil
+}
+
+func trace(op, path string) {
+	if tracing {
+		tracer(op, path)
+	}
+}
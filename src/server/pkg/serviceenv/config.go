@@ -53,6 +53,24 @@ type PachdSpecificConfiguration struct {
 	MemoryRequest         string `env:"PACHD_MEMORY_REQUEST,default=1T"`
 	WorkerUsesRoot        bool   `env:"WORKER_USES_ROOT,default=true"`
 	S3GatewayPort         uint16 `env:"S3GATEWAY_PORT,default=600"`
+	// SamlPort and SamlListenAddress determine where pachd serves its SAML
+	// services (ACS and metadata), for deployments behind non-standard
+	// ingress setups. The services' external URLs are set in the auth
+	// config's saml_svc_options
+	SamlPort          uint16 `env:"SAML_PORT,default=654"`
+	SamlListenAddress string `env:"SAML_LISTEN_ADDRESS,default="`
+	// AuthEncryptionKey, if set, is a base64-encoded 32-byte key with which
+	// pachd envelope-encrypts sensitive auth collections (tokens, one-time
+	// passwords, and the auth config) before writing them to etcd. Project it
+	// into the pachd pod from a Kubernetes secret or a cluster KMS
+	AuthEncryptionKey string `env:"AUTH_ENCRYPTION_KEY,default="`
+	// AuthTenants, if set, is a comma-separated list of auth tenant names.
+	// Each tenant is an isolated auth domain (its own admins, ACLs, tokens,
+	// and config) stored under a sub-prefix of the auth etcd prefix; requests
+	// select a tenant with the auth-tenant gRPC metadata key (see
+	// auth.ContextTenantKey), and requests without it are handled by the
+	// default domain
+	AuthTenants string `env:"AUTH_TENANTS,default="`
 }
 
 // WorkerFullConfiguration contains the full worker configuration.
@@ -2,6 +2,7 @@ package transactionenv
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -50,20 +51,22 @@ type PfsPropagater interface {
 // set of operations being performed in the Pachyderm API.  When a new
 // transaction is started, a context will be created for it containing these
 // objects, which will be threaded through to every API call:
-//   ctx: the client context which initiated the operations being performed
-//   pachClient: the APIClient associated with the client context ctx
-//   stm: the object that controls transactionality with etcd.  This is to ensure
-//     that all reads and writes are consistent until changes are committed.
-//   txnEnv: a struct containing references to each API server, it can be used
-//     to make calls to other API servers (e.g. checking auth permissions)
-//   pfsDefer: an interface for ensuring certain PFS cleanup tasks are performed
-//     properly (and deduped) at the end of the transaction.
+//
+//	ctx: the client context which initiated the operations being performed
+//	pachClient: the APIClient associated with the client context ctx
+//	stm: the object that controls transactionality with etcd.  This is to ensure
+//	  that all reads and writes are consistent until changes are committed.
+//	txnEnv: a struct containing references to each API server, it can be used
+//	  to make calls to other API servers (e.g. checking auth permissions)
+//	pfsDefer: an interface for ensuring certain PFS cleanup tasks are performed
+//	  properly (and deduped) at the end of the transaction.
 type TransactionContext struct {
 	ClientContext context.Context
 	Client        *client.APIClient
 	Stm           col.STM
 	pfsPropagater PfsPropagater
 	txnEnv        *TransactionEnv
+	rollback      []func(context.Context) error
 }
 
 // Auth returns a reference to the Auth API Server so that transactionally-
@@ -87,6 +90,30 @@ func (t *TransactionContext) PropagateCommit(branch *pfs.Branch, isNewCommit boo
 	return t.pfsPropagater.PropagateCommit(branch, isNewCommit)
 }
 
+// OnRollback enqueues a compensation action to run if a later operation in
+// the transaction fails. Writes staged in the STM roll back on their own, so
+// this is for side effects performed outside of it -- e.g. the auth server
+// undoing an ACL create if pipeline creation fails later in the same
+// transaction. Actions run in reverse order of registration, and are
+// discarded once the transaction commits.
+func (t *TransactionContext) OnRollback(f func(ctx context.Context) error) {
+	t.rollback = append(t.rollback, f)
+}
+
+// runRollback runs the enqueued compensation actions in reverse order. It is
+// best-effort: every action runs even if an earlier one fails, and the first
+// failure is returned so the caller can surface it alongside the error that
+// triggered the rollback.
+func (t *TransactionContext) runRollback(ctx context.Context) error {
+	var retErr error
+	for i := len(t.rollback) - 1; i >= 0; i-- {
+		if err := t.rollback[i](ctx); err != nil && retErr == nil {
+			retErr = err
+		}
+	}
+	return retErr
+}
+
 func (t *TransactionContext) finish() error {
 	return t.pfsPropagater.Run()
 }
@@ -105,6 +132,10 @@ type TransactionServer interface {
 // methods that can be called through the auth server.
 type AuthTransactionServer interface {
 	AuthorizeInTransaction(*TransactionContext, *auth.AuthorizeRequest) (*auth.AuthorizeResponse, error)
+	// AuthorizeBatchInTransaction evaluates authorization for many repos
+	// against one snapshot of the ACLs; the response at index i corresponds
+	// to the request at index i.
+	AuthorizeBatchInTransaction(*TransactionContext, []*auth.AuthorizeRequest) ([]*auth.AuthorizeResponse, error)
 
 	GetScopeInTransaction(*TransactionContext, *auth.GetScopeRequest) (*auth.GetScopeResponse, error)
 	SetScopeInTransaction(*TransactionContext, *auth.SetScopeRequest) (*auth.SetScopeResponse, error)
@@ -158,12 +189,13 @@ func (env *TransactionEnv) Initialize(
 // action directly or append an action to an existing transaction (depending on
 // if there is an active transaction in the client context metadata).  There
 // are two implementations of this interface:
-//  directTransaction: all operations will be run directly through the relevant
-//    server, all inside the same STM.
-//  appendTransaction: all operations will be appended to the active transaction
-//    which will then be dryrun so that the response for the operation can be
-//    returned.  Each operation that is appended will do a new dryrun, so this
-//    isn't as efficient as it could be.
+//
+//	directTransaction: all operations will be run directly through the relevant
+//	  server, all inside the same STM.
+//	appendTransaction: all operations will be appended to the active transaction
+//	  which will then be dryrun so that the response for the operation can be
+//	  returned.  Each operation that is appended will do a new dryrun, so this
+//	  isn't as efficient as it could be.
 type Transaction interface {
 	PfsWrites
 	AuthWrites
@@ -323,14 +355,46 @@ func (env *TransactionEnv) WithWriteContext(ctx context.Context, cb func(*Transa
 		}
 
 		err := cb(txnCtx)
+		if err == nil {
+			err = txnCtx.finish()
+		}
 		if err != nil {
+			if rbErr := txnCtx.runRollback(ctx); rbErr != nil {
+				return fmt.Errorf("%v (additionally, rolling back the transaction failed: %v)", err, rbErr)
+			}
 			return err
 		}
-		return txnCtx.finish()
+		return nil
 	})
 	return err
 }
 
+// WithReadSnapshot is like WithReadContext, except that every read through
+// the transaction context (ACLs, groups, admins, ...) is pinned at a single
+// etcd revision, fixed before the callback runs. This rules out torn reads --
+// e.g. a group membership change landing between the ACL read and the group
+// read of one authorization check. It returns the revision the snapshot was
+// taken at, so a caller can spread one consistent view over several calls by
+// passing the revision along ('rev' <= 0 takes a fresh snapshot).
+func (env *TransactionEnv) WithReadSnapshot(ctx context.Context, rev int64, cb func(*TransactionContext) error) (int64, error) {
+	return col.NewSnapshotSTM(ctx, env.serviceEnv.GetEtcdClient(), rev, func(stm col.STM) error {
+		pachClient := env.serviceEnv.GetPachClient(ctx)
+		txnCtx := &TransactionContext{
+			Client:        pachClient,
+			ClientContext: pachClient.Ctx(),
+			Stm:           stm,
+			pfsPropagater: env.pfsServer.NewPropagater(stm),
+			txnEnv:        env,
+		}
+
+		err := cb(txnCtx)
+		if err != nil {
+			return err
+		}
+		return txnCtx.finish()
+	})
+}
+
 // WithReadContext will call the given callback with a TransactionContext
 // which can be used to perform reads of the current cluster state. If the
 // transaction is used to perform any writes, they will be silently discarded.
@@ -346,9 +410,15 @@ func (env *TransactionEnv) WithReadContext(ctx context.Context, cb func(*Transac
 		}
 
 		err := cb(txnCtx)
+		if err == nil {
+			err = txnCtx.finish()
+		}
 		if err != nil {
+			if rbErr := txnCtx.runRollback(ctx); rbErr != nil {
+				return fmt.Errorf("%v (additionally, rolling back the transaction failed: %v)", err, rbErr)
+			}
 			return err
 		}
-		return txnCtx.finish()
+		return nil
 	})
 }
@@ -25,6 +25,15 @@ const (
 	EventError
 )
 
+// Codec transforms stored values on their way to and from etcd (e.g. to
+// encrypt them at rest). Encode is applied to marshalled values before they're
+// written, and Decode is applied to raw etcd values before they're
+// unmarshalled or delivered in watch events.
+type Codec interface {
+	Encode(data []byte) ([]byte, error)
+	Decode(data []byte) ([]byte, error)
+}
+
 // Event is an event that occurred to an item in etcd.
 type Event struct {
 	Key      []byte
@@ -81,8 +90,15 @@ func (s byModRev) Less(i, j int) bool {
 	return s.GetResponse.Kvs[i].ModRevision < s.GetResponse.Kvs[j].ModRevision
 }
 
-// NewWatcher watches a given etcd prefix for events.
-func NewWatcher(ctx context.Context, client *etcd.Client, trimPrefix, prefix string, template proto.Message, opts ...OpOption) (Watcher, error) {
+// NewWatcher watches a given etcd prefix for events. If codec is non-nil,
+// values are passed through codec.Decode before they're delivered in events.
+func NewWatcher(ctx context.Context, client *etcd.Client, trimPrefix, prefix string, template proto.Message, codec Codec, opts ...OpOption) (Watcher, error) {
+	decode := func(data []byte) ([]byte, error) {
+		if codec == nil {
+			return data, nil
+		}
+		return codec.Decode(data)
+	}
 	eventCh := make(chan *Event)
 	done := make(chan struct{})
 	// First list the collection to get the current items
@@ -120,9 +136,13 @@ func NewWatcher(ctx context.Context, client *etcd.Client, trimPrefix, prefix str
 			etcdWatcher.Close()
 		}()
 		for _, etcdKv := range resp.Kvs {
+			value, err := decode(etcdKv.Value)
+			if err != nil {
+				return err
+			}
 			eventCh <- &Event{
 				Key:      bytes.TrimPrefix(etcdKv.Key, []byte(trimPrefix)),
-				Value:    etcdKv.Value,
+				Value:    value,
 				Type:     EventPut,
 				Rev:      etcdKv.ModRevision,
 				Ver:      etcdKv.Version,
@@ -154,9 +174,13 @@ func NewWatcher(ctx context.Context, client *etcd.Client, trimPrefix, prefix str
 				return err
 			}
 			for _, etcdEv := range resp.Events {
+				value, err := decode(etcdEv.Kv.Value)
+				if err != nil {
+					return err
+				}
 				ev := &Event{
 					Key:      bytes.TrimPrefix(etcdEv.Kv.Key, []byte(trimPrefix)),
-					Value:    etcdEv.Kv.Value,
+					Value:    value,
 					Rev:      etcdEv.Kv.ModRevision,
 					Ver:      etcdEv.Kv.Version,
 					Template: template,
@@ -182,6 +206,276 @@ func NewWatcher(ctx context.Context, client *etcd.Client, trimPrefix, prefix str
 	}, nil
 }
 
+// NewBufferedWatcher is like NewWatcher, but bounds the watcher's memory use:
+// the initial listing is read from etcd in pages of 'pageSize' keys (pinned
+// to a single revision, so no item is missed or duplicated) instead of one
+// response holding the whole prefix, and events are delivered through a
+// channel buffered to the same size. Unlike NewWatcher, the initial events
+// arrive in key order rather than mod-revision order.
+func NewBufferedWatcher(ctx context.Context, client *etcd.Client, trimPrefix, prefix string, template proto.Message, codec Codec, pageSize int64, opts ...OpOption) (Watcher, error) {
+	decode := func(data []byte) ([]byte, error) {
+		if codec == nil {
+			return data, nil
+		}
+		return codec.Decode(data)
+	}
+	eventCh := make(chan *Event, pageSize)
+	done := make(chan struct{})
+	// Read the first page; its header pins the revision that the rest of the
+	// listing reads at and the watch resumes from
+	listOpts := []etcd.OpOption{
+		etcd.WithRange(etcd.GetPrefixRangeEnd(prefix)),
+		etcd.WithSort(etcd.SortByKey, etcd.SortAscend),
+		etcd.WithLimit(pageSize),
+	}
+	resp, err := client.Get(ctx, prefix, listOpts...)
+	if err != nil {
+		return nil, err
+	}
+	nextRevision := resp.Header.Revision + 1
+	etcdWatcher := etcd.NewWatcher(client)
+	options := []etcd.OpOption{etcd.WithPrefix(), etcd.WithRev(nextRevision)}
+	for _, opt := range opts {
+		options = append(options, etcd.OpOption(opt))
+	}
+	rch := etcdWatcher.Watch(ctx, prefix, options...)
+
+	go func() (retErr error) {
+		defer func() {
+			if retErr != nil {
+				select {
+				case eventCh <- &Event{
+					Err:  retErr,
+					Type: EventError,
+				}:
+				case <-done:
+				}
+			}
+			close(eventCh)
+			etcdWatcher.Close()
+		}()
+		for {
+			for _, etcdKv := range resp.Kvs {
+				value, err := decode(etcdKv.Value)
+				if err != nil {
+					return err
+				}
+				select {
+				case eventCh <- &Event{
+					Key:      bytes.TrimPrefix(etcdKv.Key, []byte(trimPrefix)),
+					Value:    value,
+					Type:     EventPut,
+					Rev:      etcdKv.ModRevision,
+					Ver:      etcdKv.Version,
+					Template: template,
+				}:
+				case <-done:
+					return nil
+				}
+			}
+			if !resp.More {
+				break
+			}
+			fromKey := string(append(resp.Kvs[len(resp.Kvs)-1].Key, 0))
+			if resp, err = client.Get(ctx, fromKey,
+				append(listOpts, etcd.WithRev(nextRevision-1))...); err != nil {
+				return err
+			}
+		}
+		for {
+			var resp etcd.WatchResponse
+			var ok bool
+			select {
+			case resp, ok = <-rch:
+			case <-done:
+				return nil
+			}
+			if !ok {
+				if err := etcdWatcher.Close(); err != nil {
+					return err
+				}
+				etcdWatcher = etcd.NewWatcher(client)
+				// use new "nextRevision"
+				options := []etcd.OpOption{etcd.WithPrefix(), etcd.WithRev(nextRevision)}
+				for _, opt := range opts {
+					options = append(options, etcd.OpOption(opt))
+				}
+				rch = etcdWatcher.Watch(ctx, prefix, options...)
+				continue
+			}
+			if err := resp.Err(); err != nil {
+				return err
+			}
+			for _, etcdEv := range resp.Events {
+				value, err := decode(etcdEv.Kv.Value)
+				if err != nil {
+					return err
+				}
+				ev := &Event{
+					Key:      bytes.TrimPrefix(etcdEv.Kv.Key, []byte(trimPrefix)),
+					Value:    value,
+					Rev:      etcdEv.Kv.ModRevision,
+					Ver:      etcdEv.Kv.Version,
+					Template: template,
+				}
+				if etcdEv.Type == etcd.EventTypePut {
+					ev.Type = EventPut
+				} else {
+					ev.Type = EventDelete
+				}
+				select {
+				case eventCh <- ev:
+				case <-done:
+					return nil
+				}
+			}
+			nextRevision = resp.Header.Revision + 1
+		}
+	}()
+
+	return &watcher{
+		eventCh: eventCh,
+		done:    done,
+	}, nil
+}
+
+// NewWatcherFromRev is like NewWatcher, but instead of listing the prefix's
+// current contents it resumes watching from etcd revision 'rev' (delivering
+// every event with revision >= rev). Callers that track the revision of the
+// last event they applied (see Event.Rev) can use this to survive a watch
+// error without re-syncing the whole collection; note that etcd compaction
+// may have discarded 'rev', in which case the watch fails and the caller must
+// fall back to a full NewWatcher.
+func NewWatcherFromRev(ctx context.Context, client *etcd.Client, trimPrefix, prefix string, template proto.Message, codec Codec, rev int64, opts ...OpOption) (Watcher, error) {
+	decode := func(data []byte) ([]byte, error) {
+		if codec == nil {
+			return data, nil
+		}
+		return codec.Decode(data)
+	}
+	eventCh := make(chan *Event)
+	done := make(chan struct{})
+	nextRevision := rev
+	etcdWatcher := etcd.NewWatcher(client)
+	options := []etcd.OpOption{etcd.WithPrefix(), etcd.WithRev(nextRevision)}
+	for _, opt := range opts {
+		options = append(options, etcd.OpOption(opt))
+	}
+	rch := etcdWatcher.Watch(ctx, prefix, options...)
+
+	go func() (retErr error) {
+		defer func() {
+			if retErr != nil {
+				select {
+				case eventCh <- &Event{
+					Err:  retErr,
+					Type: EventError,
+				}:
+				case <-done:
+				}
+			}
+			close(eventCh)
+			etcdWatcher.Close()
+		}()
+		for {
+			var resp etcd.WatchResponse
+			var ok bool
+			select {
+			case resp, ok = <-rch:
+			case <-done:
+				return nil
+			}
+			if !ok {
+				if err := etcdWatcher.Close(); err != nil {
+					return err
+				}
+				etcdWatcher = etcd.NewWatcher(client)
+				// use new "nextRevision"
+				options := []etcd.OpOption{etcd.WithPrefix(), etcd.WithRev(nextRevision)}
+				for _, opt := range opts {
+					options = append(options, etcd.OpOption(opt))
+				}
+				rch = etcdWatcher.Watch(ctx, prefix, options...)
+				continue
+			}
+			if err := resp.Err(); err != nil {
+				return err
+			}
+			for _, etcdEv := range resp.Events {
+				value, err := decode(etcdEv.Kv.Value)
+				if err != nil {
+					return err
+				}
+				ev := &Event{
+					Key:      bytes.TrimPrefix(etcdEv.Kv.Key, []byte(trimPrefix)),
+					Value:    value,
+					Rev:      etcdEv.Kv.ModRevision,
+					Ver:      etcdEv.Kv.Version,
+					Template: template,
+				}
+				if etcdEv.Type == etcd.EventTypePut {
+					ev.Type = EventPut
+				} else {
+					ev.Type = EventDelete
+				}
+				select {
+				case eventCh <- ev:
+				case <-done:
+					return nil
+				}
+			}
+			nextRevision = resp.Header.Revision + 1
+		}
+	}()
+
+	return &watcher{
+		eventCh: eventCh,
+		done:    done,
+	}, nil
+}
+
+// Filter wraps a Watcher so that only events for which 'f' returns true are
+// delivered. Error events always pass through, and an error from 'f' itself
+// is delivered as an EventError. Closing the returned Watcher closes 'w'.
+func Filter(w Watcher, f func(ev *Event) (bool, error)) Watcher {
+	eventCh := make(chan *Event)
+	done := make(chan struct{})
+	go func() {
+		defer close(eventCh)
+		for {
+			var ev *Event
+			var ok bool
+			select {
+			case ev, ok = <-w.Watch():
+			case <-done:
+				w.Close()
+				return
+			}
+			if !ok {
+				return
+			}
+			if ev.Type != EventError {
+				keep, err := f(ev)
+				if err != nil {
+					ev = &Event{Err: err, Type: EventError}
+				} else if !keep {
+					continue
+				}
+			}
+			select {
+			case eventCh <- ev:
+			case <-done:
+				w.Close()
+				return
+			}
+		}
+	}()
+	return &watcher{
+		eventCh: eventCh,
+		done:    done,
+	}
+}
+
 // MakeWatcher returns a Watcher that uses the given event channel and done
 // channel internally to deliver events and signal closure, respectively.
 func MakeWatcher(eventCh chan *Event, done chan struct{}) Watcher {